@@ -1,7 +1,9 @@
 package chomp
 
 import (
+	"sort"
 	"strings"
+	"unicode/utf8"
 )
 
 // Tag must match a series of characters at the beginning of the input text
@@ -19,6 +21,52 @@ func Tag(str string) Combinator[string] {
 	}
 }
 
+// Keyword must match a series of characters at the beginning of the input
+// text in the exact order and case provided, as long as it is not followed
+// by a letter, digit or underscore. This prevents a keyword, such as "if",
+// from matching the start of an identifier, such as "iffy". The character
+// following the keyword is not consumed.
+//
+//	chomp.Keyword("if")("if x > 0")
+//	// (" x > 0", "if", nil)
+func Keyword(word string) Combinator[string] {
+	return func(s string) (string, string, error) {
+		if strings.HasPrefix(s, word) {
+			rem := s[len(word):]
+			if r, size := utf8.DecodeRuneInString(rem); size == 0 || !(IsAlphanumeric.Match(r) || r == '_') {
+				return rem, word, nil
+			}
+		}
+
+		return s, "", CombinatorParseError{Input: word, Text: s, Type: "keyword"}
+	}
+}
+
+// TagAny must match one of the provided series of characters at the beginning
+// of the input text, in the exact case provided. The longest matching series
+// is always chosen, ensuring a shorter tag, such as "in", does not shadow a
+// longer one, such as "int".
+//
+//	chomp.TagAny("in", "int", "interface")("int64")
+//	// ("64", "int", nil)
+func TagAny(tags ...string) Combinator[string] {
+	sorted := make([]string, len(tags))
+	copy(sorted, tags)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i]) > len(sorted[j])
+	})
+
+	return func(s string) (string, string, error) {
+		for _, tag := range sorted {
+			if strings.HasPrefix(s, tag) {
+				return s[len(tag):], tag, nil
+			}
+		}
+
+		return s, "", CombinatorParseError{Input: strings.Join(tags, "|"), Text: s, Type: "tag_any"}
+	}
+}
+
 // Any must match at least one character from the provided sequence at the
 // beginning of the input text. Parsing stops upon the first unmatched character.
 //
@@ -118,6 +166,22 @@ func NoneOf(str string) Combinator[string] {
 	}
 }
 
+// AnyCharExcept must match any single character at the beginning of the
+// input text, as long as it is not within the provided sequence. It fails
+// upon an excluded character or if there is no input text.
+//
+//	chomp.AnyCharExcept(",;")("Hello, World!")
+//	// ("ello, World!", "H", nil)
+func AnyCharExcept(exclude string) Combinator[string] {
+	return func(s string) (string, string, error) {
+		if r, size := utf8.DecodeRuneInString(s); size > 0 && !strings.ContainsRune(exclude, r) {
+			return s[size:], s[:size], nil
+		}
+
+		return s, "", CombinatorParseError{Input: exclude, Text: s, Type: "any_char_except"}
+	}
+}
+
 // Until will scan the input text for the first occurrence of the provided series
 // of characters. Everything until that point in the text will be matched.
 //
@@ -132,3 +196,18 @@ func Until(str string) Combinator[string] {
 		return s, "", CombinatorParseError{Input: str, Text: s, Type: "until"}
 	}
 }
+
+// SplitBy will split the entire input text into all segments separated by the
+// provided series of characters, in the same way as [strings.Split]. Empty
+// segments are preserved. If the input text starts or ends with the separator,
+// the first or last segment will be empty. Input text without the separator
+// results in a single segment. An empty separator splits the input text after
+// each rune. It will never return an error.
+//
+//	chomp.SplitBy(",")(",Hello,,World!,")
+//	// ("", []string{"", "Hello", "", "World!", ""}, nil)
+func SplitBy(sep string) Combinator[[]string] {
+	return func(s string) (string, []string, error) {
+		return "", strings.Split(s, sep), nil
+	}
+}
@@ -1,6 +1,7 @@
 package chomp_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/purpleclay/chomp"
@@ -233,6 +234,210 @@ func TestNoneOf(t *testing.T) {
 	}
 }
 
+func TestAnyCharExcept(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		exclude string
+		input   string
+		rem     string
+		ext     string
+	}{
+		{
+			name:    "Ascii",
+			exclude: ",;",
+			input:   "Hello, World!",
+			rem:     "ello, World!",
+			ext:     "H",
+		},
+		{
+			name:    "Unicode",
+			exclude: "、。",
+			input:   "こんにちは、おはよう",
+			rem:     "んにちは、おはよう",
+			ext:     "こ",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.AnyCharExcept(tt.exclude)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestAnyCharExceptScansUntilExcluded(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Flatten(chomp.Many(chomp.AnyCharExcept("=;")))("key=value;")
+
+	require.NoError(t, err)
+	assert.Equal(t, "=value;", rem)
+	assert.Equal(t, "key", ext)
+}
+
+func TestAnyCharExceptError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Excluded",
+			input: "、おはよう",
+		},
+		{
+			name:  "Empty",
+			input: "",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.AnyCharExcept("、。")(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(any_char_except) combinator failed")
+		})
+	}
+}
+
+func TestKeyword(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+	}{
+		{
+			name:  "FollowedBySpace",
+			input: "if x > 0",
+			rem:   " x > 0",
+		},
+		{
+			name:  "FollowedByPunctuation",
+			input: "if(x > 0)",
+			rem:   "(x > 0)",
+		},
+		{
+			name:  "EndOfInput",
+			input: "if",
+			rem:   "",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.Keyword("if")(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, "if", ext)
+		})
+	}
+}
+
+func TestKeywordError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Letter",
+			input: "iffield",
+		},
+		{
+			name:  "Digit",
+			input: "if2",
+		},
+		{
+			name:  "Underscore",
+			input: "if_",
+		},
+		{
+			name:  "UnicodeLetter",
+			input: "ifé",
+		},
+		{
+			name:  "NoMatch",
+			input: "else",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.Keyword("if")(tt.input)
+
+			assert.Equal(t, tt.input, rem)
+			assert.EqualError(t, err, fmt.Sprintf("(keyword) combinator failed to parse text '%s' with input 'if'", tt.input))
+		})
+	}
+}
+
+func TestTagAny(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Shortest",
+			input: "in range",
+			rem:   " range",
+			ext:   "in",
+		},
+		{
+			name:  "LongestWins",
+			input: "int64",
+			rem:   "64",
+			ext:   "int",
+		},
+		{
+			name:  "Unicode",
+			input: "こんにちは、おはよう",
+			rem:   "、おはよう",
+			ext:   "こんにちは",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.TagAny("in", "int", "interface", "こん", "こんにちは")(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestTagAnyError(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.TagAny("in", "int", "interface")("float64")
+
+	assert.Equal(t, "float64", rem)
+	assert.EqualError(t, err, "(tag_any) combinator failed to parse text 'float64' with input 'in|int|interface'")
+}
+
 func TestCombinatorError(t *testing.T) {
 	t.Parallel()
 
@@ -251,3 +456,70 @@ func TestParserCombinatorError(t *testing.T) {
 
 	assert.EqualError(t, err, "(all) parser failed. (tag) combinator failed to parse text 'dc:9781801260336:£19.99' with input 'marvel'")
 }
+
+func TestSplitBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		sep      string
+		segments []string
+	}{
+		{
+			name:     "Segments",
+			input:    "john,smith,42",
+			sep:      ",",
+			segments: []string{"john", "smith", "42"},
+		},
+		{
+			name:     "EmptySegments",
+			input:    "a,,b",
+			sep:      ",",
+			segments: []string{"a", "", "b"},
+		},
+		{
+			name:     "LeadingAndTrailingSeparator",
+			input:    ",Hello,World!,",
+			sep:      ",",
+			segments: []string{"", "Hello", "World!", ""},
+		},
+		{
+			name:     "MultiCharacterSeparator",
+			input:    "こんにちは::世界::!",
+			sep:      "::",
+			segments: []string{"こんにちは", "世界", "!"},
+		},
+		{
+			name:     "NoSeparator",
+			input:    "Hello, World!",
+			sep:      "|",
+			segments: []string{"Hello, World!"},
+		},
+		{
+			name:     "EmptyInput",
+			input:    "",
+			sep:      ",",
+			segments: []string{""},
+		},
+		{
+			name:     "EmptySeparator",
+			input:    "héllo",
+			sep:      "",
+			segments: []string{"h", "é", "l", "l", "o"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, segments, err := chomp.SplitBy(tt.sep)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, "", rem)
+			assert.Equal(t, tt.segments, segments)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package chomp
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// MakeRuleParts contains the individual parts of a parsed make rule.
+type MakeRuleParts struct {
+	// Targets contains each target declared before the colon.
+	Targets []string
+
+	// Prereqs contains each prerequisite declared after the colon.
+	Prereqs []string
+}
+
+// MakeRule will parse the first line of a make rule, separating its targets
+// from its prerequisites. Filenames can contain escaped whitespace ("\ ")
+// and a rule can span multiple lines through the use of a line continuation
+// ("\" at the end of a line). Both single and double-colon rules are
+// supported. Parsing stops at the end of the line, which is consumed, or
+// before a semicolon that introduces an inline recipe.
+//
+//	chomp.MakeRule()("build test: go.mod main.go\n\tgo build")
+//	// ("\tgo build", chomp.MakeRuleParts{Targets: []string{"build", "test"}, Prereqs: []string{"go.mod", "main.go"}}, nil)
+func MakeRule() Combinator[MakeRuleParts] {
+	return func(s string) (string, MakeRuleParts, error) {
+		var rule MakeRuleParts
+
+		rem, targets := makeWords(s, ':')
+		if len(targets) == 0 {
+			return s, rule, CombinatorParseError{Text: s, Type: "make_rule"}
+		}
+
+		rem, _, err := First(Tag("::"), Tag(":"))(rem)
+		if err != nil {
+			return s, rule, ParserError{Err: err, Type: "make_rule"}
+		}
+
+		rem, prereqs := makeWords(rem, ';')
+		rem, _, _ = Opt(Crlf())(rem)
+
+		rule.Targets = targets
+		rule.Prereqs = prereqs
+		return rem, rule, nil
+	}
+}
+
+func makeWords(s string, stop byte) (string, []string) {
+	var words []string
+	var word strings.Builder
+
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+
+	i := 0
+scan:
+	for i < len(s) {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			if rem, _, err := Crlf()(s[i+1:]); err == nil {
+				flush()
+				i = len(s) - len(rem)
+				continue
+			}
+
+			if next := s[i+1]; next == ' ' || next == '\t' || next == ':' || next == '\\' {
+				word.WriteByte(next)
+				i += 2
+				continue
+			}
+
+			word.WriteByte(c)
+		case c == ' ' || c == '\t':
+			flush()
+		case c == '\n' || c == '\r' || c == stop:
+			break scan
+		default:
+			word.WriteByte(c)
+		}
+		i++
+	}
+	flush()
+
+	return s[i:], words
+}
+
+// DockerfileInstructionParts contains the individual parts of a parsed
+// Dockerfile instruction.
+type DockerfileInstructionParts struct {
+	// Cmd is the instruction, normalized to uppercase.
+	Cmd string
+
+	// Args contains the raw arguments of the instruction. Any line
+	// continuations will have been removed.
+	Args string
+
+	// Exec contains the parsed arguments if they were written in the
+	// JSON array (exec) form. Empty otherwise.
+	Exec []string
+}
+
+// DockerfileInstruction will parse a single Dockerfile instruction, such as
+// RUN, COPY or ENV, along with its arguments. Any leading blank lines and
+// comments are skipped. Arguments can span multiple lines through the use of
+// a line continuation ("\" at the end of a line), with any comment lines
+// between them being discarded. Arguments written in the JSON array form,
+// CMD ["sh", "-c"], are also parsed. The line ending is consumed.
+//
+//	chomp.DockerfileInstruction()("# base image\nFROM golang:1.22\nRUN go build")
+//	// ("RUN go build", chomp.DockerfileInstructionParts{Cmd: "FROM", Args: "golang:1.22"}, nil)
+func DockerfileInstruction() Combinator[DockerfileInstructionParts] {
+	return func(s string) (string, DockerfileInstructionParts, error) {
+		var inst DockerfileInstructionParts
+
+		rem, _, _ := ManyN(dockerfileIgnoredLine(), 0)(s)
+
+		rem, cmd, err := Prefixed(While(IsLetter), WhileN(isBlank{}, 0))(rem)
+		if err != nil {
+			return s, inst, ParserError{Err: err, Type: "dockerfile_instruction"}
+		}
+
+		if _, _, err := First(While(isBlank{}), Crlf(), dockerfileEOF())(rem); err != nil {
+			return s, inst, ParserError{Err: err, Type: "dockerfile_instruction"}
+		}
+
+		var args strings.Builder
+		for {
+			var line string
+			rem, line, _ = Eol()(rem)
+
+			trimmed := strings.TrimRight(line, " \t")
+			if !strings.HasSuffix(trimmed, "\\") {
+				args.WriteString(line)
+				break
+			}
+			args.WriteString(trimmed[:len(trimmed)-1])
+
+			rem, _, _ = ManyN(dockerfileIgnoredLine(), 0)(rem)
+			if rem == "" {
+				break
+			}
+		}
+
+		inst.Cmd = strings.ToUpper(cmd)
+		inst.Args = strings.TrimSpace(args.String())
+		if strings.HasPrefix(inst.Args, "[") {
+			var exec []string
+			if json.Unmarshal([]byte(inst.Args), &exec) == nil {
+				inst.Exec = exec
+			}
+		}
+
+		return rem, inst, nil
+	}
+}
+
+type isBlank struct{}
+
+func (isBlank) Match(r rune) bool {
+	return r == ' ' || r == '\t'
+}
+
+func (isBlank) String() string {
+	return "is_blank"
+}
+
+func dockerfileIgnoredLine() Combinator[string] {
+	return Suffixed(
+		Prefixed(
+			Opt(Flatten(Pair(Tag("#"), WhileNotN(IsLineEnding, 0)))),
+			WhileN(isBlank{}, 0),
+		),
+		Crlf(),
+	)
+}
+
+func dockerfileEOF() Combinator[string] {
+	return func(s string) (string, string, error) {
+		if s == "" {
+			return s, "", nil
+		}
+
+		return s, "", CombinatorParseError{Text: s, Type: "eof"}
+	}
+}
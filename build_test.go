@@ -0,0 +1,175 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		rule  chomp.MakeRuleParts
+	}{
+		{
+			name:  "TargetsAndPrereqs",
+			input: "build test: go.mod main.go\n\tgo build",
+			rem:   "\tgo build",
+			rule: chomp.MakeRuleParts{
+				Targets: []string{"build", "test"},
+				Prereqs: []string{"go.mod", "main.go"},
+			},
+		},
+		{
+			name:  "NoPrereqs",
+			input: ".PHONY:\r\n",
+			rem:   "",
+			rule: chomp.MakeRuleParts{
+				Targets: []string{".PHONY"},
+			},
+		},
+		{
+			name:  "EscapedSpaces",
+			input: `my\ file.o: my\ file.c	common.h`,
+			rem:   "",
+			rule: chomp.MakeRuleParts{
+				Targets: []string{"my file.o"},
+				Prereqs: []string{"my file.c", "common.h"},
+			},
+		},
+		{
+			name:  "LineContinuation",
+			input: "all: one \\\n\ttwo\\\r\nthree\n",
+			rem:   "",
+			rule: chomp.MakeRuleParts{
+				Targets: []string{"all"},
+				Prereqs: []string{"one", "two", "three"},
+			},
+		},
+		{
+			name:  "DoubleColon",
+			input: "clean:: tidy",
+			rem:   "",
+			rule: chomp.MakeRuleParts{
+				Targets: []string{"clean"},
+				Prereqs: []string{"tidy"},
+			},
+		},
+		{
+			name:  "InlineRecipe",
+			input: "lint: fmt; golangci-lint run",
+			rem:   "; golangci-lint run",
+			rule: chomp.MakeRuleParts{
+				Targets: []string{"lint"},
+				Prereqs: []string{"fmt"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, rule, err := chomp.MakeRule()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.rule, rule)
+		})
+	}
+}
+
+func TestMakeRuleInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.MakeRule()(": go.mod")
+	require.Error(t, err)
+
+	_, _, err = chomp.MakeRule()("build go.mod\n")
+	require.Error(t, err)
+}
+
+func TestDockerfileInstruction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		inst  chomp.DockerfileInstructionParts
+	}{
+		{
+			name:  "Simple",
+			input: "FROM golang:1.22\nRUN go build",
+			rem:   "RUN go build",
+			inst:  chomp.DockerfileInstructionParts{Cmd: "FROM", Args: "golang:1.22"},
+		},
+		{
+			name:  "SkipsCommentsAndBlankLines",
+			input: "# syntax=docker/dockerfile:1\n\n  # base image\r\nENV CGO_ENABLED=0",
+			rem:   "",
+			inst:  chomp.DockerfileInstructionParts{Cmd: "ENV", Args: "CGO_ENABLED=0"},
+		},
+		{
+			name:  "LowercaseInstruction",
+			input: "copy . /src\n",
+			rem:   "",
+			inst:  chomp.DockerfileInstructionParts{Cmd: "COPY", Args: ". /src"},
+		},
+		{
+			name: "LineContinuation",
+			input: `RUN apt-get update && \
+    # install git
+    apt-get install -y git \
+    && rm -rf /var/lib/apt/lists/*
+WORKDIR /src`,
+			rem: "WORKDIR /src",
+			inst: chomp.DockerfileInstructionParts{
+				Cmd:  "RUN",
+				Args: "apt-get update &&     apt-get install -y git     && rm -rf /var/lib/apt/lists/*",
+			},
+		},
+		{
+			name:  "ExecForm",
+			input: `CMD ["sh", "-c", "echo \"hello\""]`,
+			rem:   "",
+			inst: chomp.DockerfileInstructionParts{
+				Cmd:  "CMD",
+				Args: `["sh", "-c", "echo \"hello\""]`,
+				Exec: []string{"sh", "-c", `echo "hello"`},
+			},
+		},
+		{
+			name:  "NoArguments",
+			input: "HEALTHCHECK\n",
+			rem:   "",
+			inst:  chomp.DockerfileInstructionParts{Cmd: "HEALTHCHECK"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, inst, err := chomp.DockerfileInstruction()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.inst, inst)
+		})
+	}
+}
+
+func TestDockerfileInstructionInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.DockerfileInstruction()("# only a comment")
+	require.Error(t, err)
+
+	_, _, err = chomp.DockerfileInstruction()("FROM:golang")
+	require.Error(t, err)
+}
@@ -1,11 +1,14 @@
 package chomp
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
 
-// Result is the expected output from a [Combinator].
+// Result is the expected output from a [Combinator] that can be combined
+// into a sequence, such as [Pair] or [All], where each parsed value is
+// flattened into a string slice.
 type Result interface {
 	string | []string
 }
@@ -14,7 +17,14 @@ type Result interface {
 // condition. Combinators can be combined to form more complex parsers. Upon success,
 // a combinator will return both the unparsed and parsed text. All combinators are
 // strict and must parse its input. Any failure to do so should raise a [CombinatorParseError].
-type Combinator[T Result] func(string) (string, T, error)
+//
+// A combinator can return any type, but only those satisfying [Result] can be
+// flattened by the sequence combinators. A [MappedCombinator] can be converted
+// into a combinator of its mapped type, allowing it to be used with combinators
+// that accept any type, such as [First], [Opt] and [ManyOf]:
+//
+//	chomp.Combinator[int](chomp.Map(chomp.While(chomp.IsDigit), toInt))
+type Combinator[T any] func(string) (string, T, error)
 
 const truncateErrAt = 50
 
@@ -70,6 +80,98 @@ func (e ParserError) Unwrap() error {
 	return e.Err
 }
 
+// LabelError defines an error that is raised when a labelled [Combinator]
+// fails to parse the input text. It annotates the underlying error with a
+// human-readable label, identifying the grammar rule that failed.
+type LabelError struct {
+	// Err contains the error that caused the labelled [Combinator] to fail.
+	Err error
+
+	// Label is the human-readable name of the grammar rule.
+	Label string
+}
+
+// Error returns a friendly string representation of the current error.
+func (e LabelError) Error() string {
+	return fmt.Sprintf("while parsing %q: %v", e.Label, e.Err)
+}
+
+// Unwrap returns the inner error.
+func (e LabelError) Unwrap() error {
+	return e.Err
+}
+
+// AltError defines an error that is raised when every branch of an [Alt]
+// combinator fails to parse the input text. Each branch error is retained,
+// ordered by how much input the branch consumed before failing, with the
+// branch that consumed the most listed first.
+type AltError struct {
+	// Errs contains the error raised by each branch.
+	Errs []error
+}
+
+// Error returns a friendly string representation of the current error.
+func (e AltError) Error() string {
+	var buf strings.Builder
+	buf.WriteString("(alt) parser failed")
+	for i, err := range e.Errs {
+		buf.WriteString(fmt.Sprintf(". [%d] %v", i+1, err))
+	}
+
+	return buf.String()
+}
+
+// Unwrap returns the error raised by each branch.
+func (e AltError) Unwrap() []error {
+	return e.Errs
+}
+
+// ParseError defines an error that is raised when [Parse] fails to parse the
+// input text. It identifies the [Position] within the input text where parsing
+// failed.
+type ParseError struct {
+	// Err contains the error that caused parsing to fail.
+	Err error
+
+	// Pos is the furthest position within the input text reached before
+	// parsing failed.
+	Pos Position
+}
+
+// Error returns a friendly string representation of the current error.
+func (e ParseError) Error() string {
+	return fmt.Sprintf("(parse) parser failed at %s. %v", e.Pos, e.Err)
+}
+
+// Unwrap returns the inner error.
+func (e ParseError) Unwrap() error {
+	return e.Err
+}
+
+// FatalError defines an error that is raised when a [Combinator] wrapped
+// by [Cut] fails to parse the input text. It signals that the parser has
+// committed to its current branch, preventing [First] and [Alt] from
+// trying any remaining alternatives.
+type FatalError struct {
+	// Err contains the error that caused the committed [Combinator] to fail.
+	Err error
+}
+
+// Error returns a friendly string representation of the current error.
+func (e FatalError) Error() string {
+	return fmt.Sprintf("(cut) parser failed. %v", e.Err)
+}
+
+// Unwrap returns the inner error.
+func (e FatalError) Unwrap() error {
+	return e.Err
+}
+
+func isFatal(err error) bool {
+	var fatal FatalError
+	return errors.As(err, &fatal)
+}
+
 // RangedParserError defines an error that is raised when a ranged parser
 // fails to parse the input text due to a failed [Combinator] within the
 // expected execution range.
@@ -0,0 +1,400 @@
+package chomp
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ConfigNode represents a single directive within an nginx-style config.
+// A directive that opens a block will contain its nested directives
+// as children.
+type ConfigNode struct {
+	// Name of the directive.
+	Name string
+
+	// Args contains each argument of the directive. Quoted arguments are
+	// returned without their quotes, with any escaped characters resolved.
+	Args []string
+
+	// Children contains the nested directives of a block directive.
+	Children []ConfigNode
+
+	// Block is true if the directive opens a block.
+	Block bool
+}
+
+type isConfigToken struct{}
+
+func (isConfigToken) Match(r rune) bool {
+	return !unicode.IsSpace(r) && r != ';' && r != '{' && r != '}' && r != '#' && r != '"' && r != '\''
+}
+
+func (isConfigToken) String() string {
+	return "is_config_token"
+}
+
+// ConfigBlock will parse an nginx-style config into a tree of directives.
+// A directive is terminated by either a semicolon (name args;) or a block
+// of nested directives (name args { ... }). Arguments can be quoted using
+// either single or double quotes, and comments (#) are ignored. The returned
+// root [ConfigNode] has no name and contains all top-level directives as its
+// children. Parsing stops at the end of the input or at an unmatched closing
+// brace, which is not consumed.
+//
+//	chomp.ConfigBlock()("events { worker_connections 1024; }")
+//	// ("", chomp.ConfigNode{Children: []chomp.ConfigNode{{Name: "events", Block: true, Children: ...}}}, nil)
+func ConfigBlock() Combinator[ConfigNode] {
+	return func(s string) (string, ConfigNode, error) {
+		rem, children, err := configDirectives(s)
+		if err != nil {
+			return s, ConfigNode{}, ParserError{Err: err, Type: "config_block"}
+		}
+
+		return rem, ConfigNode{Children: children}, nil
+	}
+}
+
+func configDirectives(s string) (string, []ConfigNode, error) {
+	var nodes []ConfigNode
+
+	rem := s
+	for {
+		rem, _, _ = configIgnored()(rem)
+		if rem == "" || rem[0] == '}' {
+			return rem, nodes, nil
+		}
+
+		var node ConfigNode
+		var err error
+		if rem, node, err = configDirective(rem); err != nil {
+			return rem, nil, err
+		}
+		nodes = append(nodes, node)
+	}
+}
+
+func configDirective(s string) (string, ConfigNode, error) {
+	var node ConfigNode
+
+	rem, name, err := While(isConfigToken{})(s)
+	if err != nil {
+		return rem, node, err
+	}
+	node.Name = name
+
+	for {
+		rem, _, _ = configIgnored()(rem)
+
+		var ext string
+		if rem, ext, err = First(Tag(";"), Tag("{"))(rem); err == nil {
+			if ext == ";" {
+				return rem, node, nil
+			}
+
+			node.Block = true
+			if rem, node.Children, err = configDirectives(rem); err != nil {
+				return rem, node, err
+			}
+
+			rem, _, err = Tag("}")(rem)
+			return rem, node, err
+		}
+
+		var arg string
+		if rem, arg, err = configArg()(rem); err != nil {
+			return rem, node, err
+		}
+		node.Args = append(node.Args, arg)
+	}
+}
+
+func configArg() Combinator[string] {
+	unescape := func(r rune) (string, error) { return string(r), nil }
+
+	return First(
+		EscapedTransform('"', '\\', unescape),
+		EscapedTransform('\'', '\\', unescape),
+		While(isConfigToken{}),
+	)
+}
+
+func configIgnored() Combinator[[]string] {
+	return ManyN(First(
+		Flatten(Pair(Tag("#"), WhileNotN(IsLineEnding, 0))),
+		While(IsMultispace),
+	), 0)
+}
+
+// SystemdUnit will parse a systemd unit file into a map of sections, where
+// each section maps a key to all of its values. Repeated keys are collected
+// in the order they appear. A value can span multiple lines through the use
+// of a line continuation ("\\" at the end of a line), with each continued
+// line joined by a single space. Lines starting with either '#' or ';' are
+// treated as comments and ignored. The entire input is parsed.
+//
+//	chomp.SystemdUnit()("[Unit]\nDescription=chomp\nAfter=network.target\nAfter=sshd.service")
+//	// ("", map[string]map[string][]string{"Unit": {"Description": {"chomp"}, "After": {"network.target", "sshd.service"}}}, nil)
+func SystemdUnit() Combinator[map[string]map[string][]string] {
+	return func(s string) (string, map[string]map[string][]string, error) {
+		unit := map[string]map[string][]string{}
+
+		var section map[string][]string
+		rem := s
+		for rem != "" {
+			var line string
+			rem, line, _ = Eol()(rem)
+
+			line = strings.TrimSpace(line)
+			if line == "" || line[0] == '#' || line[0] == ';' {
+				continue
+			}
+
+			if _, name, err := Delimited(Tag("["), Until("]"), Tag("]"))(line); err == nil {
+				if _, ok := unit[name]; !ok {
+					unit[name] = map[string][]string{}
+				}
+				section = unit[name]
+				continue
+			}
+
+			_, kv, err := SepPair(Until("="), Tag("="), WhileNotN(IsLineEnding, 0))(line)
+			if err != nil || section == nil {
+				return s, nil, CombinatorParseError{Text: line, Type: "systemd_unit"}
+			}
+
+			value := strings.TrimSpace(kv[1])
+			for strings.HasSuffix(value, "\\") && rem != "" {
+				var next string
+				rem, next, _ = Eol()(rem)
+
+				next = strings.TrimSpace(next)
+				if next != "" && (next[0] == '#' || next[0] == ';') {
+					next = "\\"
+				}
+				value = strings.TrimSpace(value[:len(value)-1]) + " " + next
+			}
+
+			key := strings.TrimSpace(kv[0])
+			section[key] = append(section[key], strings.TrimSpace(strings.TrimSuffix(value, "\\")))
+		}
+
+		return "", unit, nil
+	}
+}
+
+// NetrcMachine contains the credentials for a single machine within a .netrc file.
+type NetrcMachine struct {
+	// Name of the machine. Empty if this is the default entry.
+	Name string
+
+	// Default is true if this entry matches any machine.
+	Default bool
+
+	// Login is the user name.
+	Login string
+
+	// Password for the user.
+	Password string
+
+	// Account is an additional account password.
+	Account string
+
+	// Macros contains each macro defined for the machine, keyed by name.
+	Macros map[string]string
+}
+
+// Netrc will parse a .netrc file into a list of machine entries. A .netrc
+// file is made up of whitespace separated tokens, where each machine (or
+// default) token starts a new entry, followed by any number of login,
+// password, account or macdef tokens. A macro (macdef) body starts on the
+// line after its name and continues until the first blank line. Tokens can
+// be quoted using double quotes, and comments (#) are ignored. The entire
+// input is parsed.
+//
+//	chomp.Netrc()("machine api.github.com login octocat password s3cr3t")
+//	// ("", []chomp.NetrcMachine{{Name: "api.github.com", Login: "octocat", Password: "s3cr3t"}}, nil)
+func Netrc() Combinator[[]NetrcMachine] {
+	unescape := func(r rune) (string, error) { return string(r), nil }
+	token := Preceded(
+		configIgnored(),
+		First(EscapedTransform('"', '\\', unescape), While(isNetrcToken{})),
+	)
+
+	return func(s string) (string, []NetrcMachine, error) {
+		var machines []NetrcMachine
+
+		rem := s
+		for {
+			var keyword string
+			var err error
+
+			if rem, _, _ = configIgnored()(rem); rem == "" {
+				return rem, machines, nil
+			}
+
+			if rem, keyword, err = token(rem); err != nil {
+				return rem, nil, ParserError{Err: err, Type: "netrc"}
+			}
+
+			if keyword == "default" {
+				machines = append(machines, NetrcMachine{Default: true})
+				continue
+			}
+
+			var value string
+			if rem, value, err = token(rem); err != nil {
+				return rem, nil, ParserError{Err: err, Type: "netrc"}
+			}
+
+			if keyword == "machine" {
+				machines = append(machines, NetrcMachine{Name: value})
+				continue
+			}
+
+			if len(machines) == 0 {
+				return rem, nil, CombinatorParseError{Input: keyword, Text: rem, Type: "netrc"}
+			}
+			machine := &machines[len(machines)-1]
+
+			switch keyword {
+			case "login":
+				machine.Login = value
+			case "password":
+				machine.Password = value
+			case "account":
+				machine.Account = value
+			case "macdef":
+				var body string
+				rem, _, _ = Eol()(rem)
+				rem, body = netrcMacro(rem)
+
+				if machine.Macros == nil {
+					machine.Macros = map[string]string{}
+				}
+				machine.Macros[value] = body
+			default:
+				return rem, nil, CombinatorParseError{Input: keyword, Text: rem, Type: "netrc"}
+			}
+		}
+	}
+}
+
+func netrcMacro(s string) (string, string) {
+	idx := strings.Index(s, "\n\n")
+	if idx == -1 {
+		return "", s
+	}
+
+	return s[idx+2:], s[:idx+1]
+}
+
+type isNetrcToken struct{}
+
+func (isNetrcToken) Match(r rune) bool {
+	return !unicode.IsSpace(r) && r != '#'
+}
+
+func (isNetrcToken) String() string {
+	return "is_netrc_token"
+}
+
+// ConfigChange contains a single key that has changed between two versions
+// of a key-value config.
+type ConfigChange struct {
+	// Key that has changed.
+	Key string
+
+	// Old value of the key. This will be empty if the key was added.
+	Old string
+
+	// New value of the key. This will be empty if the key was removed.
+	New string
+}
+
+// ConfigDiff will parse a diff-style block of changes to a key-value config,
+// pairing any removed (-key=old) and added (+key=new) lines by their key. The
+// provided [Combinator] parses the text of each line after its prefix, and must
+// return the key followed by its value. Unchanged context lines, prefixed by a
+// space, are ignored. Parsing stops at the first line without a diff prefix.
+// Any file headers, such as --- a/app.conf, must be consumed beforehand. Changes
+// are returned in the order each key first appears.
+//
+//	chomp.ConfigDiff(chomp.SepPair(chomp.Until("="), chomp.Tag("="), chomp.Eol()))(
+//		"-port=8080\n+port=9090\n+debug=true\n")
+//	// ("", []chomp.ConfigChange{{Key: "port", Old: "8080", New: "9090"}, {Key: "debug", New: "true"}}, nil)
+func ConfigDiff(kv Combinator[[]string]) Combinator[[]ConfigChange] {
+	return func(s string) (string, []ConfigChange, error) {
+		var changes []ConfigChange
+		index := map[string]int{}
+
+		rem := s
+		for rem != "" && strings.ContainsRune("-+ ", rune(rem[0])) {
+			prefix := rem[0]
+
+			next, line, _ := Eol()(rem[1:])
+			rem = next
+			if prefix == ' ' {
+				continue
+			}
+
+			_, pair, err := kv(line)
+			if err != nil {
+				return s, nil, ParserError{Err: err, Type: "config_diff"}
+			}
+
+			if len(pair) < 2 {
+				return s, nil, CombinatorParseError{Text: line, Type: "config_diff"}
+			}
+
+			i, ok := index[pair[0]]
+			if !ok {
+				i = len(changes)
+				index[pair[0]] = i
+				changes = append(changes, ConfigChange{Key: pair[0]})
+			}
+
+			if prefix == '-' {
+				changes[i].Old = pair[1]
+			} else {
+				changes[i].New = pair[1]
+			}
+		}
+
+		if len(changes) == 0 {
+			return s, nil, CombinatorParseError{Text: s, Type: "config_diff"}
+		}
+
+		return rem, changes, nil
+	}
+}
+
+// KeyValue will parse a single key-value line, such as those found within
+// an INI or properties file, returning the key followed by its value. The key
+// is all text before the first match of the separator, and the value is the
+// remainder of the line, allowing it to contain the separator. Surrounding
+// whitespace is trimmed from both. The key must not be empty, but the value
+// can be. The line ending is consumed.
+//
+//	chomp.KeyValue(chomp.OneOf("=:"))("url = https://example.com?a=b\nkey2=value2")
+//	// ("key2=value2", []string{"url", "https://example.com?a=b"}, nil)
+func KeyValue(sep Combinator[string]) Combinator[[]string] {
+	return func(s string) (string, []string, error) {
+		rem, line, _ := Eol()(s)
+
+		for i := range line {
+			value, _, err := sep(line[i:])
+			if err != nil {
+				continue
+			}
+
+			key := strings.TrimSpace(line[:i])
+			if key == "" {
+				break
+			}
+
+			return rem, []string{key, strings.TrimSpace(value)}, nil
+		}
+
+		return s, nil, CombinatorParseError{Text: line, Type: "key_value"}
+	}
+}
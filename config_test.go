@@ -0,0 +1,417 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigBlock(t *testing.T) {
+	t.Parallel()
+
+	input := `# global settings
+user nginx;
+worker_processes auto;
+
+http {
+    include mime.types;
+    server {
+        listen 80 default_server; # IPv4
+        server_name "example.com" 'www.example.com';
+        location / {
+            return 200 "hello \"world\"";
+        }
+    }
+}
+`
+
+	rem, root, err := chomp.ConfigBlock()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, chomp.ConfigNode{
+		Children: []chomp.ConfigNode{
+			{Name: "user", Args: []string{"nginx"}},
+			{Name: "worker_processes", Args: []string{"auto"}},
+			{
+				Name:  "http",
+				Block: true,
+				Children: []chomp.ConfigNode{
+					{Name: "include", Args: []string{"mime.types"}},
+					{
+						Name:  "server",
+						Block: true,
+						Children: []chomp.ConfigNode{
+							{Name: "listen", Args: []string{"80", "default_server"}},
+							{Name: "server_name", Args: []string{"example.com", "www.example.com"}},
+							{
+								Name:  "location",
+								Args:  []string{"/"},
+								Block: true,
+								Children: []chomp.ConfigNode{
+									{Name: "return", Args: []string{"200", `hello "world"`}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, root)
+}
+
+func TestConfigBlockEmptyBlock(t *testing.T) {
+	t.Parallel()
+
+	rem, root, err := chomp.ConfigBlock()("events {}}")
+
+	require.NoError(t, err)
+	assert.Equal(t, "}", rem)
+	assert.Equal(t, chomp.ConfigNode{
+		Children: []chomp.ConfigNode{{Name: "events", Block: true}},
+	}, root)
+}
+
+func TestConfigBlockInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "MissingSemicolon",
+			input: "user nginx",
+		},
+		{
+			name:  "UnclosedBlock",
+			input: "http { include mime.types;",
+		},
+		{
+			name:  "UnterminatedQuote",
+			input: `server_name "example.com;`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.ConfigBlock()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestSystemdUnit(t *testing.T) {
+	t.Parallel()
+
+	input := `# chomp.service
+[Unit]
+Description=Chomp parser
+After=network.target
+After=sshd.service
+
+[Service]
+; run as a dedicated user
+User = chomp
+ExecStart=/usr/bin/chomp \
+    --config /etc/chomp.yaml \
+    # the port is configurable
+    --port 8080
+Environment=
+
+[Install]
+WantedBy=multi-user.target
+`
+
+	rem, unit, err := chomp.SystemdUnit()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, map[string]map[string][]string{
+		"Unit": {
+			"Description": {"Chomp parser"},
+			"After":       {"network.target", "sshd.service"},
+		},
+		"Service": {
+			"User":        {"chomp"},
+			"ExecStart":   {"/usr/bin/chomp --config /etc/chomp.yaml --port 8080"},
+			"Environment": {""},
+		},
+		"Install": {
+			"WantedBy": {"multi-user.target"},
+		},
+	}, unit)
+}
+
+func TestSystemdUnitInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "KeyOutsideSection",
+			input: "Description=chomp\n[Unit]",
+		},
+		{
+			name:  "MissingSeparator",
+			input: "[Unit]\nDescription chomp",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.SystemdUnit()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestNetrc(t *testing.T) {
+	t.Parallel()
+
+	input := `# personal credentials
+machine api.github.com
+  login octocat
+  password "s3cr3t pass"
+
+machine ftp.example.com login anonymous password guest account acct
+macdef init
+cd /pub
+binary
+
+default login guest password guest
+`
+
+	rem, machines, err := chomp.Netrc()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, []chomp.NetrcMachine{
+		{Name: "api.github.com", Login: "octocat", Password: "s3cr3t pass"},
+		{
+			Name:     "ftp.example.com",
+			Login:    "anonymous",
+			Password: "guest",
+			Account:  "acct",
+			Macros:   map[string]string{"init": "cd /pub\nbinary\n"},
+		},
+		{Default: true, Login: "guest", Password: "guest"},
+	}, machines)
+}
+
+func TestNetrcInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "UnknownToken",
+			input: "machine example.com user octocat",
+		},
+		{
+			name:  "TokenBeforeMachine",
+			input: "login octocat machine example.com",
+		},
+		{
+			name:  "MissingValue",
+			input: "machine example.com login",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, _, err := chomp.Netrc()(tt.input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestConfigDiff(t *testing.T) {
+	t.Parallel()
+
+	input := `-port=8080
++port=9090
+ host=localhost
+-verbose=true
++debug=true
+-timeout=30
++timeout=60
+@@ -10 +10 @@`
+
+	rem, changes, err := chomp.ConfigDiff(
+		chomp.SepPair(chomp.Until("="), chomp.Tag("="), chomp.Eol()))(input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "@@ -10 +10 @@", rem)
+	assert.Equal(t, []chomp.ConfigChange{
+		{Key: "port", Old: "8080", New: "9090"},
+		{Key: "verbose", Old: "true"},
+		{Key: "debug", New: "true"},
+		{Key: "timeout", Old: "30", New: "60"},
+	}, changes)
+}
+
+func TestConfigDiffCustomLine(t *testing.T) {
+	t.Parallel()
+
+	rem, changes, err := chomp.ConfigDiff(
+		chomp.SepPair(
+			chomp.While(chomp.IsAlphanumeric),
+			chomp.Delimited(chomp.Spaces0(), chomp.Tag(":"), chomp.Spaces0()),
+			chomp.Eol()))("+replicas: 3\r\n-replicas:2\r\n")
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, []chomp.ConfigChange{{Key: "replicas", Old: "2", New: "3"}}, changes)
+}
+
+func TestConfigDiffInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "NoChanges",
+			input: " port=8080\nhost=localhost",
+			err:   "(config_diff) combinator failed",
+		},
+		{
+			name:  "InvalidLine",
+			input: "-port=8080\n+port\n",
+			err:   "(config_diff) parser failed",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.ConfigDiff(
+				chomp.SepPair(chomp.Until("="), chomp.Tag("="), chomp.Eol()))(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestKeyValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		sep   chomp.Combinator[string]
+		rem   string
+		ext   []string
+	}{
+		{
+			name:  "Equals",
+			input: "name=chomp\nversion=1.0",
+			sep:   chomp.Tag("="),
+			rem:   "version=1.0",
+			ext:   []string{"name", "chomp"},
+		},
+		{
+			name:  "Whitespace",
+			input: "  name \t=  chomp parser  \r\n",
+			sep:   chomp.Tag("="),
+			rem:   "",
+			ext:   []string{"name", "chomp parser"},
+		},
+		{
+			name:  "Colon",
+			input: "Content-Type: text/plain",
+			sep:   chomp.OneOf("=:"),
+			rem:   "",
+			ext:   []string{"Content-Type", "text/plain"},
+		},
+		{
+			name:  "EmptyValue",
+			input: "password =\nuser=admin",
+			sep:   chomp.Tag("="),
+			rem:   "user=admin",
+			ext:   []string{"password", ""},
+		},
+		{
+			name:  "ValueContainsSeparator",
+			input: "url = https://example.com?a=b&c=d",
+			sep:   chomp.OneOf("=:"),
+			rem:   "",
+			ext:   []string{"url", "https://example.com?a=b&c=d"},
+		},
+		{
+			name:  "MultiCharacterSeparator",
+			input: "key := value",
+			sep:   chomp.Tag(":="),
+			rem:   "",
+			ext:   []string{"key", "value"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.KeyValue(tt.sep)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestKeyValueInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "MissingSeparator",
+			input: "name chomp\nversion=1.0",
+		},
+		{
+			name:  "EmptyKey",
+			input: "  = chomp",
+		},
+		{
+			name:  "SeparatorOnNextLine",
+			input: "name\n=chomp",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.KeyValue(chomp.Tag("="))(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(key_value) combinator failed to parse text")
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package chomp
+
+import "strings"
+
+// CrontabKind identifies the type of line within a crontab.
+type CrontabKind int
+
+const (
+	// CrontabEnv is an environment variable assignment, KEY=value.
+	CrontabEnv CrontabKind = iota
+
+	// CrontabSpecial is a command scheduled using a special string, such
+	// as @reboot or @daily.
+	CrontabSpecial
+
+	// CrontabSchedule is a command scheduled using the standard five
+	// time and date fields.
+	CrontabSchedule
+)
+
+// CrontabLine contains the individual parts of a parsed crontab line.
+// The fields that are set depend on its [CrontabKind].
+type CrontabLine struct {
+	// Kind of crontab line.
+	Kind CrontabKind
+
+	// Name of the environment variable. Only set for [CrontabEnv].
+	Name string
+
+	// Value of the environment variable, with any surrounding quotes
+	// removed. Only set for [CrontabEnv].
+	Value string
+
+	// Schedule contains either the special string (@daily) for a
+	// [CrontabSpecial], or the five time and date fields for a
+	// [CrontabSchedule].
+	Schedule []string
+
+	// Command to execute. Not set for [CrontabEnv].
+	Command string
+}
+
+var crontabSpecials = map[string]bool{
+	"@reboot":   true,
+	"@yearly":   true,
+	"@annually": true,
+	"@monthly":  true,
+	"@weekly":   true,
+	"@daily":    true,
+	"@midnight": true,
+	"@hourly":   true,
+}
+
+type isCronField struct{}
+
+func (isCronField) Match(r rune) bool {
+	return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') ||
+		r == '*' || r == ',' || r == '-' || r == '/'
+}
+
+func (isCronField) String() string {
+	return "is_cron_field"
+}
+
+// Crontab will parse a crontab file into a list of environment variable
+// assignments and scheduled commands. A command can either be scheduled
+// using a special string (@reboot, @yearly, @annually, @monthly, @weekly,
+// @daily, @midnight or @hourly) or the standard five time and date fields.
+// Blank lines and comments (#) are skipped. The entire input is parsed.
+//
+//	chomp.Crontab()("MAILTO=ops@example.com\n@reboot /usr/bin/warmup\n*/5 * * * * /usr/bin/sync\n")
+//	// ("", []chomp.CrontabLine{
+//	//	{Kind: chomp.CrontabEnv, Name: "MAILTO", Value: "ops@example.com"},
+//	//	{Kind: chomp.CrontabSpecial, Schedule: []string{"@reboot"}, Command: "/usr/bin/warmup"},
+//	//	{Kind: chomp.CrontabSchedule, Schedule: []string{"*/5", "*", "*", "*", "*"}, Command: "/usr/bin/sync"},
+//	// }, nil)
+func Crontab() Combinator[[]CrontabLine] {
+	return func(s string) (string, []CrontabLine, error) {
+		var lines []CrontabLine
+
+		rem := s
+		for rem != "" {
+			var text string
+			rem, text, _ = Eol()(rem)
+
+			text = strings.TrimSpace(text)
+			if text == "" || text[0] == '#' {
+				continue
+			}
+
+			line, err := crontabLine(text)
+			if err != nil {
+				return s, nil, ParserError{Err: err, Type: "crontab"}
+			}
+			lines = append(lines, line)
+		}
+
+		return "", lines, nil
+	}
+}
+
+func crontabLine(text string) (CrontabLine, error) {
+	field := Terminated(While(isCronField{}), While(isBlank{}))
+
+	switch {
+	case text[0] == '@':
+		rem, special, err := Terminated(Preceded(Tag("@"), While(IsLetter)), While(isBlank{}))(text)
+		if err != nil || !crontabSpecials["@"+special] {
+			return CrontabLine{}, CombinatorParseError{Text: text, Type: "crontab_special"}
+		}
+
+		return CrontabLine{Kind: CrontabSpecial, Schedule: []string{"@" + special}, Command: rem}, nil
+	case text[0] == '*' || IsDigit.Match(rune(text[0])):
+		rem, fields, err := Repeat(field, 5)(text)
+		if err != nil {
+			return CrontabLine{}, err
+		}
+
+		return CrontabLine{Kind: CrontabSchedule, Schedule: fields, Command: rem}, nil
+	}
+
+	_, kv, err := SepPair(Until("="), Tag("="), WhileNotN(IsLineEnding, 0))(text)
+	if err != nil {
+		return CrontabLine{}, err
+	}
+
+	name := strings.TrimSpace(kv[0])
+	if name == "" || strings.ContainsAny(name, " \t") {
+		return CrontabLine{}, CombinatorParseError{Text: text, Type: "crontab_env"}
+	}
+
+	value := strings.TrimSpace(kv[1])
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+
+	return CrontabLine{Kind: CrontabEnv, Name: name, Value: value}, nil
+}
@@ -0,0 +1,74 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrontab(t *testing.T) {
+	t.Parallel()
+
+	input := `# m h dom mon dow command
+SHELL=/bin/bash
+MAILTO = "ops@example.com"
+
+@reboot   /usr/local/bin/warmup --all
+*/5 * * * *	/usr/bin/sync > /dev/null 2>&1
+0 9-17 * JAN,FEB mon-fri /usr/bin/report
+`
+
+	rem, lines, err := chomp.Crontab()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, []chomp.CrontabLine{
+		{Kind: chomp.CrontabEnv, Name: "SHELL", Value: "/bin/bash"},
+		{Kind: chomp.CrontabEnv, Name: "MAILTO", Value: "ops@example.com"},
+		{Kind: chomp.CrontabSpecial, Schedule: []string{"@reboot"}, Command: "/usr/local/bin/warmup --all"},
+		{Kind: chomp.CrontabSchedule, Schedule: []string{"*/5", "*", "*", "*", "*"}, Command: "/usr/bin/sync > /dev/null 2>&1"},
+		{Kind: chomp.CrontabSchedule, Schedule: []string{"0", "9-17", "*", "JAN,FEB", "mon-fri"}, Command: "/usr/bin/report"},
+	}, lines)
+}
+
+func TestCrontabInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "UnknownSpecial",
+			input: "@fortnightly /usr/bin/backup\n",
+		},
+		{
+			name:  "SpecialMissingCommand",
+			input: "@daily\n",
+		},
+		{
+			name:  "TooFewFields",
+			input: "*/5 * * /usr/bin/sync\n",
+		},
+		{
+			name:  "MissingCommand",
+			input: "*/5 * * * *\n",
+		},
+		{
+			name:  "MalformedEnv",
+			input: "export PATH\n",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.Crontab()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
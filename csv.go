@@ -0,0 +1,103 @@
+package chomp
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+type isCSVText struct {
+	delim rune
+}
+
+func (p isCSVText) Match(r rune) bool {
+	return r != p.delim && r != '"' && r != '\r' && r != '\n'
+}
+
+func (isCSVText) String() string {
+	return "is_csv_text"
+}
+
+// CSVRecord will parse a single record of a CSV file, as defined by RFC 4180,
+// into its fields. Fields are separated by the delimiter. A field enclosed
+// within double quotes may contain the delimiter, line endings and doubled
+// quotes (""), which are decoded as a single quote. A quote within an unquoted
+// field is rejected. Parsing stops at the end of the record, consuming its line
+// ending.
+//
+// The delimiter must be a valid rune other than a double quote, '\r' or '\n'.
+// In the same way as [encoding/csv], an invalid delimiter is rejected with
+// an error before any input text is parsed.
+//
+//	chomp.CSVRecord(',')("1,\"Smith, \"\"John\"\"\",\"line1\nline2\"\n2,...")
+//	// ("2,...", []string{"1", "Smith, \"John\"", "line1\nline2"}, nil)
+func CSVRecord(delim rune) Combinator[[]string] {
+	validDelim := delim != '"' && delim != '\r' && delim != '\n' &&
+		delim != utf8.RuneError && utf8.ValidRune(delim)
+
+	return func(s string) (string, []string, error) {
+		if !validDelim {
+			return s, nil, CombinatorParseError{Input: string(delim), Text: s, Type: "csv_invalid_delim"}
+		}
+
+		if s == "" {
+			return s, nil, CombinatorParseError{Text: s, Type: "csv_record"}
+		}
+
+		var fields []string
+		rem := s
+		for {
+			var field string
+			var err error
+			if strings.HasPrefix(rem, `"`) {
+				rem, field, err = csvQuotedField(rem)
+			} else {
+				rem, field, _ = WhileN(isCSVText{delim: delim}, 0)(rem)
+				if strings.HasPrefix(rem, `"`) {
+					err = CombinatorParseError{Text: rem, Type: "csv_bare_quote"}
+				}
+			}
+
+			if err != nil {
+				return s, nil, ParserError{Err: err, Type: "csv_record"}
+			}
+			fields = append(fields, field)
+
+			if next, _, err := Tag(string(delim))(rem); err == nil {
+				rem = next
+				continue
+			}
+
+			if rem == "" {
+				return rem, fields, nil
+			}
+
+			if rem, _, err = Crlf()(rem); err != nil {
+				return s, nil, ParserError{Err: err, Type: "csv_record"}
+			}
+			return rem, fields, nil
+		}
+	}
+}
+
+// csvQuotedField matches a field enclosed within double quotes, decoding
+// any doubled quotes
+func csvQuotedField(s string) (string, string, error) {
+	var buf strings.Builder
+
+	rem := s[1:]
+	for {
+		idx := strings.IndexByte(rem, '"')
+		if idx == -1 {
+			return s, "", CombinatorParseError{Input: `"`, Text: s, Type: "csv_quoted_field"}
+		}
+
+		buf.WriteString(rem[:idx])
+		rem = rem[idx+1:]
+		if !strings.HasPrefix(rem, `"`) {
+			return rem, buf.String(), nil
+		}
+
+		buf.WriteByte('"')
+		rem = rem[1:]
+	}
+}
@@ -0,0 +1,181 @@
+package chomp_test
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVRecord(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  string
+		delim  rune
+		rem    string
+		fields []string
+	}{
+		{
+			name:   "Unquoted",
+			input:  "id,name,email\n1,john,john@example.com",
+			delim:  ',',
+			rem:    "1,john,john@example.com",
+			fields: []string{"id", "name", "email"},
+		},
+		{
+			name:   "CRLF",
+			input:  "a,b\r\nc,d",
+			delim:  ',',
+			rem:    "c,d",
+			fields: []string{"a", "b"},
+		},
+		{
+			name:   "NoLineEnding",
+			input:  "a,b",
+			delim:  ',',
+			rem:    "",
+			fields: []string{"a", "b"},
+		},
+		{
+			name:   "EmptyFields",
+			input:  ",,\n",
+			delim:  ',',
+			rem:    "",
+			fields: []string{"", "", ""},
+		},
+		{
+			name:   "QuotedDelimiter",
+			input:  `"Smith, John",42`,
+			delim:  ',',
+			rem:    "",
+			fields: []string{"Smith, John", "42"},
+		},
+		{
+			name:   "DoubledQuotes",
+			input:  `"She said ""hello""","""",""` + "\n",
+			delim:  ',',
+			rem:    "",
+			fields: []string{`She said "hello"`, `"`, ""},
+		},
+		{
+			name:   "EmbeddedNewline",
+			input:  "1,\"line1\r\nline2\"\n2,next",
+			delim:  ',',
+			rem:    "2,next",
+			fields: []string{"1", "line1\r\nline2"},
+		},
+		{
+			name:   "TabDelimited",
+			input:  "a\t\"b\tc\"\td",
+			delim:  '\t',
+			rem:    "",
+			fields: []string{"a", "b\tc", "d"},
+		},
+		{
+			name:   "MultibyteDelimiter",
+			input:  "a§b,c",
+			delim:  '§',
+			rem:    "",
+			fields: []string{"a", "b,c"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, fields, err := chomp.CSVRecord(tt.delim)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.fields, fields)
+		})
+	}
+}
+
+func TestCSVRecordInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   "(csv_record) combinator failed to parse text ''",
+		},
+		{
+			name:  "UnterminatedQuote",
+			input: "1,\"unterminated\n2,next",
+			err:   "(csv_quoted_field) combinator failed to parse text '\"unterminated\n2,next' with input '\"'",
+		},
+		{
+			name:  "BareQuote",
+			input: `1,say "hi"`,
+			err:   `(csv_bare_quote) combinator failed to parse text '"hi"'`,
+		},
+		{
+			name:  "TextAfterClosingQuote",
+			input: `"quoted"text,2`,
+			err:   "(crlf) combinator failed to parse text 'text,2'",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.CSVRecord(',')(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestCSVRecordMany(t *testing.T) {
+	t.Parallel()
+
+	rem, records, err := chomp.Many(chomp.CSVRecord(','))("a,b\n\"c\nd\",e\n")
+
+	require.NoError(t, err)
+	assert.Equal(t, "", rem)
+	assert.Equal(t, []string{"a", "b", "c\nd", "e"}, records)
+}
+
+func TestCSVRecordInvalidDelimiter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		delim rune
+	}{
+		{name: "DoubleQuote", delim: '"'},
+		{name: "CarriageReturn", delim: '\r'},
+		{name: "LineFeed", delim: '\n'},
+		{name: "RuneError", delim: utf8.RuneError},
+		{name: "InvalidRune", delim: -1},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.CSVRecord(tt.delim)("a\"b")
+
+			require.Error(t, err)
+			assert.Equal(t, "a\"b", rem)
+			assert.ErrorContains(t, err, "(csv_invalid_delim) combinator failed")
+		})
+	}
+}
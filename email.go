@@ -0,0 +1,82 @@
+package chomp
+
+import "strings"
+
+type isEmailAtext struct{}
+
+func (isEmailAtext) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+		strings.ContainsRune("!#$%&'*+/=?^_`{|}~-", r)
+}
+
+func (isEmailAtext) String() string {
+	return "is_email_atext"
+}
+
+type isEmailLabel struct{}
+
+func (isEmailLabel) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-'
+}
+
+func (isEmailLabel) String() string {
+	return "is_email_label"
+}
+
+// Email will parse an email address using a pragmatic subset of RFC 5322,
+// returning the matched address. The accepted grammar is:
+//
+//	email  = local "@" domain
+//	local  = atom *("." atom)
+//	atom   = 1*(ALPHA / DIGIT / "!#$%&'*+/=?^_`{|}~-")
+//	domain = label 1*("." label)
+//	label  = ALPHA / DIGIT [*(ALPHA / DIGIT / "-") (ALPHA / DIGIT)]
+//
+// Quoted local parts, comments and IP address literals are not supported. As
+// a domain must contain at least one dot, and neither part can contain spaces
+// or consecutive dots, any trailing punctuation, such as a full stop ending a
+// sentence, is not consumed.
+//
+//	chomp.Email()("john.smith@example.com.")
+//	// (".", "john.smith@example.com", nil)
+func Email() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, _, err := DottedPath(While(isEmailAtext{}))(s)
+		if err != nil {
+			return s, "", ParserError{Err: err, Type: "email"}
+		}
+
+		if rem, _, err = Tag("@")(rem); err != nil {
+			return s, "", ParserError{Err: err, Type: "email"}
+		}
+
+		rem, labels, err := DottedPath(emailLabel())(rem)
+		if err != nil {
+			return s, "", ParserError{Err: err, Type: "email"}
+		}
+
+		if len(labels) < 2 {
+			return s, "", ParserError{
+				Err:  CombinatorParseError{Text: labels[0], Type: "email_domain"},
+				Type: "email",
+			}
+		}
+
+		return rem, s[:len(s)-len(rem)], nil
+	}
+}
+
+func emailLabel() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, label, err := While(isEmailLabel{})(s)
+		if err != nil {
+			return s, "", err
+		}
+
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return s, "", CombinatorParseError{Text: label, Type: "email_label"}
+		}
+
+		return rem, label, nil
+	}
+}
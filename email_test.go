@@ -0,0 +1,118 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Simple",
+			input: "john@example.com",
+			rem:   "",
+			ext:   "john@example.com",
+		},
+		{
+			name:  "DottedLocal",
+			input: "john.smith@mail.example.co.uk>",
+			rem:   ">",
+			ext:   "john.smith@mail.example.co.uk",
+		},
+		{
+			name:  "SpecialCharacters",
+			input: "user+tag!#$%&'*/=?^_`{|}~-@my-host.io, bob@x.y",
+			rem:   ", bob@x.y",
+			ext:   "user+tag!#$%&'*/=?^_`{|}~-@my-host.io",
+		},
+		{
+			name:  "EndOfSentence",
+			input: "albert.einstein@emcsqua.red.",
+			rem:   ".",
+			ext:   "albert.einstein@emcsqua.red",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.Email()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestEmailInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "MissingAt",
+			input: "john.example.com",
+			err:   "(tag) combinator failed to parse text '' with input '@'",
+		},
+		{
+			name:  "SpaceInLocal",
+			input: "john smith@example.com",
+			err:   "(tag) combinator failed to parse text ' smith@example.com' with input '@'",
+		},
+		{
+			name:  "ConsecutiveDots",
+			input: "john..smith@example.com",
+			err:   "with input '@'",
+		},
+		{
+			name:  "LeadingDot",
+			input: ".john@example.com",
+			err:   "(dotted_path) parser failed",
+		},
+		{
+			name:  "NoDomainDot",
+			input: "root@localhost",
+			err:   "(email_domain) combinator failed to parse text 'localhost'",
+		},
+		{
+			name:  "HyphenatedLabel",
+			input: "john@-example.com",
+			err:   "(email_label) combinator failed to parse text '-example'",
+		},
+		{
+			name:  "EmptyDomain",
+			input: "john@",
+			err:   "(dotted_path) parser failed",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.Email()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(email) parser failed")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -203,9 +203,9 @@ func user() chomp.Combinator[[]string] {
 
 		var ext []string
 		if rem, ext, err = chomp.SepPair(
-			chomp.Until(" "),
+			chomp.Until(" <"),
 			chomp.Tag(" "),
-			chomp.BracketAngled())(rem); err != nil {
+			chomp.Delimited(chomp.Tag("<"), chomp.Email(), chomp.Tag(">")))(rem); err != nil {
 			return rem, nil, err
 		}
 
@@ -0,0 +1,690 @@
+package chomp
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GitConfig will parse a git config file into a map of sections, where each
+// section maps a key to its value. Section and key names are case-insensitive
+// and normalized to lowercase. A subsection, [remote "origin"], is case-sensitive
+// and identified by joining it to its section with a dot, remote.origin. A key
+// without a value is a boolean shorthand for true. Leading and trailing whitespace
+// is removed from a value, with any internal whitespace outside of quotes converted
+// into spaces. Values can be quoted, contain escape sequences (\", \\, \n, \t
+// and \b) and span multiple lines through the use of a line continuation ("\" at
+// the end of a line). Comments ('#' or ';') are ignored. If a key is repeated, the
+// last value wins. The entire input is parsed.
+//
+//	chomp.GitConfig()("[core]\n\tbare = false\n[remote \"origin\"]\n\turl = https://github.com/purpleclay/chomp\n")
+//	// ("", map[string]map[string]string{"core": {"bare": "false"}, "remote.origin": {"url": "https://github.com/purpleclay/chomp"}}, nil)
+func GitConfig() Combinator[map[string]map[string]string] {
+	return func(s string) (string, map[string]map[string]string, error) {
+		config := map[string]map[string]string{}
+
+		var section map[string]string
+		rem := s
+		for {
+			rem, _, _ = configIgnored()(rem)
+			if rem == "" {
+				return rem, config, nil
+			}
+
+			if rem[0] == '[' {
+				var name string
+				var err error
+				if rem, name, err = gitConfigSection()(rem); err != nil {
+					return rem, nil, ParserError{Err: err, Type: "git_config"}
+				}
+
+				if _, ok := config[name]; !ok {
+					config[name] = map[string]string{}
+				}
+				section = config[name]
+				continue
+			}
+
+			if section == nil {
+				return rem, nil, CombinatorParseError{Text: rem, Type: "git_config"}
+			}
+
+			var kv []string
+			var err error
+			if rem, kv, err = gitConfigVariable()(rem); err != nil {
+				return rem, nil, ParserError{Err: err, Type: "git_config"}
+			}
+			section[kv[0]] = kv[1]
+		}
+	}
+}
+
+type isGitConfigName struct{}
+
+func (isGitConfigName) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '.'
+}
+
+func (isGitConfigName) String() string {
+	return "is_git_config_name"
+}
+
+func gitConfigSection() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, ext, err := Delimited(
+			Tag("["),
+			Pair(
+				While(isGitConfigName{}),
+				Opt(Preceded(While(isBlank{}), EscapedTransform('"', '\\', func(r rune) (string, error) {
+					return string(r), nil
+				})))),
+			Tag("]"))(s)
+		if err != nil {
+			return rem, "", err
+		}
+
+		name := strings.ToLower(ext[0])
+		if ext[1] != "" {
+			name += "." + ext[1]
+		}
+
+		return rem, name, nil
+	}
+}
+
+func gitConfigVariable() Combinator[[]string] {
+	return func(s string) (string, []string, error) {
+		rem, name, err := Terminated(While(isGitConfigName{}), WhileN(isBlank{}, 0))(s)
+		if err != nil {
+			return rem, nil, err
+		}
+		name = strings.ToLower(name)
+
+		if rem, _, err = Terminated(Tag("="), WhileN(isBlank{}, 0))(rem); err != nil {
+			// A key without a value is a boolean shorthand for true
+			rem, _, _ = Opt(Preceded(Tag("#"), WhileNotN(IsLineEnding, 0)))(rem)
+			rem, _, _ = Opt(Preceded(Tag(";"), WhileNotN(IsLineEnding, 0)))(rem)
+			if rem != "" {
+				if rem, _, err = Crlf()(rem); err != nil {
+					return rem, nil, err
+				}
+			}
+			return rem, []string{name, "true"}, nil
+		}
+
+		rem, value, err := gitConfigValue(rem)
+		if err != nil {
+			return rem, nil, err
+		}
+
+		return rem, []string{name, value}, nil
+	}
+}
+
+func gitConfigValue(s string) (string, string, error) {
+	var buf strings.Builder
+	var quoted bool
+
+	// Mirroring git, whitespace outside of quotes is only retained between
+	// words, with each whitespace character being converted into a space
+	pending := 0
+
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case c == '\\':
+			if i+1 >= len(s) {
+				return s, "", CombinatorParseError{Text: s, Type: "git_config_value"}
+			}
+
+			if rem, _, err := Crlf()(s[i+1:]); err == nil {
+				i = len(s) - len(rem)
+				continue
+			}
+
+			decoded, ok := map[byte]string{'"': `"`, '\\': `\`, 'n': "\n", 't': "\t", 'b': "\b"}[s[i+1]]
+			if !ok {
+				return s, "", CombinatorParseError{Text: s[i:], Type: "git_config_value"}
+			}
+			buf.WriteString(strings.Repeat(" ", pending))
+			buf.WriteString(decoded)
+			pending = 0
+			i += 2
+			continue
+		case c == '"':
+			quoted = !quoted
+		case (c == '\n' || c == '\r') && !quoted:
+			rem, _, _ := Crlf()(s[i:])
+			return rem, buf.String(), nil
+		case (c == '\n' || c == '\r') && quoted:
+			return s, "", CombinatorParseError{Input: `"`, Text: s, Type: "git_config_value"}
+		case (c == '#' || c == ';') && !quoted:
+			rem, _, _ := Eol()(s[i:])
+			return rem, buf.String(), nil
+		case (c == ' ' || c == '\t') && !quoted:
+			if buf.Len() > 0 {
+				pending++
+			}
+		default:
+			buf.WriteString(strings.Repeat(" ", pending))
+			buf.WriteByte(c)
+			pending = 0
+		}
+		i++
+	}
+
+	if quoted {
+		return s, "", CombinatorParseError{Input: `"`, Text: s, Type: "git_config_value"}
+	}
+
+	return "", buf.String(), nil
+}
+
+// RefspecParts contains the individual parts of a parsed git refspec.
+type RefspecParts struct {
+	// Force is true if the refspec was prefixed with a '+', permitting
+	// non fast-forward updates.
+	Force bool
+
+	// Src is the source ref pattern. It is empty when deleting a ref
+	// through a push refspec, :refs/heads/feature.
+	Src string
+
+	// Dst is the destination ref pattern. It is empty if the refspec
+	// has no destination.
+	Dst string
+}
+
+type isRefspecText struct{}
+
+func (isRefspecText) Match(r rune) bool {
+	return r > ' ' && r != 0x7f && !strings.ContainsRune(":~^?[\\", r)
+}
+
+func (isRefspecText) String() string {
+	return "is_refspec_text"
+}
+
+// Refspec will parse a git refspec, in the format [+]<src>[:<dst>]. A leading
+// '+' marks the refspec as forced. Both the source and destination can contain
+// a single '*' wildcard, but if one side does, so must the other. Characters that
+// are invalid within a git ref name, such as whitespace, '~', '^', '?', '[' and
+// '\', are not matched.
+//
+//	chomp.Refspec()("+refs/heads/*:refs/remotes/origin/*")
+//	// ("", chomp.RefspecParts{Force: true, Src: "refs/heads/*", Dst: "refs/remotes/origin/*"}, nil)
+func Refspec() Combinator[RefspecParts] {
+	return func(s string) (string, RefspecParts, error) {
+		rem, force, _ := Opt(Tag("+"))(s)
+		rem, src, _ := WhileN(isRefspecText{}, 0)(rem)
+		rem, dst, _ := Opt(Preceded(Tag(":"), WhileN(isRefspecText{}, 0)))(rem)
+
+		if src == "" && dst == "" {
+			return s, RefspecParts{}, CombinatorParseError{Text: s, Type: "refspec"}
+		}
+
+		globs := strings.Count(src, "*")
+		if globs > 1 || strings.Count(dst, "*") > 1 || (dst != "" && src != "" && globs != strings.Count(dst, "*")) {
+			return s, RefspecParts{}, CombinatorParseError{Input: "*", Text: s, Type: "refspec"}
+		}
+
+		return rem, RefspecParts{Force: force == "+", Src: src, Dst: dst}, nil
+	}
+}
+
+// StatusEntry contains the parsed details of a single entry from the
+// output of git status --porcelain=v1.
+type StatusEntry struct {
+	// Staged is the status of the path within the index. Possible values
+	// are: ' ' (unmodified), 'M', 'T', 'A', 'D', 'R', 'C', 'U', '?' and '!'.
+	Staged rune
+
+	// Unstaged is the status of the path within the working tree. It shares
+	// the same possible values as Staged.
+	Unstaged rune
+
+	// Path to the file relative to the root of the repository. If the path
+	// was quoted by git, it is unquoted.
+	Path string
+
+	// OrigPath is the original path of a renamed or copied file.
+	OrigPath string
+}
+
+// PorcelainStatus will parse the output of git status --porcelain=v1, where
+// each line contains a two character status code (XY) followed by a path,
+// XY PATH. A renamed or copied file also contains its original path, using
+// the format XY ORIG_PATH -> PATH. Any path containing special characters
+// will have been quoted by git, using C-style escape sequences, and is
+// unquoted. The entire input is parsed.
+//
+//	chomp.PorcelainStatus()(" M README.md\nR  old.go -> new.go\n?? notes.txt\n")
+//	// ("", []chomp.StatusEntry{{' ', 'M', "README.md", ""}, {'R', ' ', "new.go", "old.go"}, {'?', '?', "notes.txt", ""}}, nil)
+func PorcelainStatus() Combinator[[]StatusEntry] {
+	return func(s string) (string, []StatusEntry, error) {
+		var entries []StatusEntry
+
+		rem := s
+		for rem != "" {
+			var entry StatusEntry
+			var err error
+			if rem, entry, err = porcelainStatusLine(rem); err != nil {
+				return rem, nil, ParserError{Err: err, Type: "porcelain_status"}
+			}
+			entries = append(entries, entry)
+		}
+
+		return rem, entries, nil
+	}
+}
+
+func porcelainStatusLine(s string) (string, StatusEntry, error) {
+	var entry StatusEntry
+
+	rem, xy, err := Terminated(Repeat(OneOf(" MTADRCU?!"), 2), Tag(" "))(s)
+	if err != nil {
+		return s, entry, err
+	}
+	entry.Staged, entry.Unstaged = rune(xy[0][0]), rune(xy[1][0])
+
+	if strings.ContainsAny(xy[0]+xy[1], "RC") {
+		if rem, entry.OrigPath, err = Terminated(gitPath(" -> "), Tag(" -> "))(rem); err != nil {
+			return s, StatusEntry{}, err
+		}
+	}
+
+	if rem, entry.Path, err = gitPath("\n")(rem); err != nil {
+		return s, StatusEntry{}, err
+	}
+
+	if rem != "" {
+		if rem, _, err = Crlf()(rem); err != nil {
+			return s, StatusEntry{}, err
+		}
+	}
+
+	return rem, entry, nil
+}
+
+// gitPath matches a path that is either quoted by git or terminated by
+// the delimiter or a line ending
+func gitPath(delim string) Combinator[string] {
+	return func(s string) (string, string, error) {
+		if strings.HasPrefix(s, `"`) {
+			return gitUnquote(s)
+		}
+
+		end := strings.IndexAny(s, "\r\n")
+		if end == -1 {
+			end = len(s)
+		}
+
+		if idx := strings.Index(s[:end], delim); idx != -1 {
+			end = idx
+		}
+
+		if end == 0 {
+			return s, "", CombinatorParseError{Text: s, Type: "git_path"}
+		}
+
+		return s[end:], s[:end], nil
+	}
+}
+
+func gitUnquote(s string) (string, string, error) {
+	var buf strings.Builder
+
+	i := 1
+	for i < len(s) {
+		switch c := s[i]; c {
+		case '"':
+			return s[i+1:], buf.String(), nil
+		case '\n', '\r':
+			return s, "", CombinatorParseError{Input: `"`, Text: s, Type: "git_path"}
+		case '\\':
+			if i+1 >= len(s) {
+				return s, "", CombinatorParseError{Text: s, Type: "git_path"}
+			}
+
+			if decoded, ok := map[byte]byte{
+				'a': '\a', 'b': '\b', 't': '\t', 'n': '\n', 'v': '\v',
+				'f': '\f', 'r': '\r', '"': '"', '\\': '\\',
+			}[s[i+1]]; ok {
+				buf.WriteByte(decoded)
+				i += 2
+				continue
+			}
+
+			// Any other byte, such as a multi-byte UTF-8 sequence, is escaped
+			// as a three digit octal number
+			if i+4 > len(s) {
+				return s, "", CombinatorParseError{Text: s[i:], Type: "git_path"}
+			}
+
+			var b byte
+			for _, o := range s[i+1 : i+4] {
+				if o < '0' || o > '7' {
+					return s, "", CombinatorParseError{Text: s[i:], Type: "git_path"}
+				}
+				b = b<<3 | byte(o-'0')
+			}
+			buf.WriteByte(b)
+			i += 4
+		default:
+			buf.WriteByte(c)
+			i++
+		}
+	}
+
+	return s, "", CombinatorParseError{Input: `"`, Text: s, Type: "git_path"}
+}
+
+// BlameLine contains the parsed details of a single line from the output
+// of git blame --porcelain. Details about the commit are only written by
+// git the first time a commit is seen, but are copied into every line that
+// is attributed to it.
+type BlameLine struct {
+	// Hash of the commit the line is attributed to.
+	Hash string
+
+	// OrigLine is the line number within the original file.
+	OrigLine int
+
+	// FinalLine is the line number within the final file.
+	FinalLine int
+
+	// Author of the commit.
+	Author string
+
+	// AuthorMail is the email address of the author, without any
+	// surrounding angle brackets.
+	AuthorMail string
+
+	// AuthorTime is when the commit was authored, within the timezone
+	// of the author.
+	AuthorTime time.Time
+
+	// Committer of the commit.
+	Committer string
+
+	// CommitterMail is the email address of the committer, without any
+	// surrounding angle brackets.
+	CommitterMail string
+
+	// CommitterTime is when the commit was committed, within the timezone
+	// of the committer.
+	CommitterTime time.Time
+
+	// Summary is the first line of the commit message.
+	Summary string
+
+	// Filename of the file within the commit the line is attributed to.
+	Filename string
+
+	// Content of the line, without its leading tab.
+	Content string
+}
+
+type isHexDigit struct{}
+
+func (isHexDigit) Match(r rune) bool {
+	return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F')
+}
+
+func (isHexDigit) String() string {
+	return "is_hex_digit"
+}
+
+// BlamePorcelain will parse the output of git blame --porcelain (or
+// --line-porcelain). Each line starts with a header, containing the commit
+// hash, its original and final line numbers, followed by any number of key
+// value pairs describing the commit, and ends with its content prefixed by
+// a tab. Unrecognized keys, such as previous and boundary, are ignored. The
+// entire input is parsed.
+//
+//	chomp.BlamePorcelain()("8f2b1c...e4 1 1 1\nauthor purpleclay\nauthor-mail <purpleclay@github.com>\n...\n\tpackage chomp\n")
+//	// ("", []chomp.BlameLine{{Hash: "8f2b1c...e4", OrigLine: 1, FinalLine: 1, Author: "purpleclay", ..., Content: "package chomp"}}, nil)
+func BlamePorcelain() Combinator[[]BlameLine] {
+	return func(s string) (string, []BlameLine, error) {
+		var lines []BlameLine
+		commits := map[string]BlameLine{}
+
+		rem := s
+		for rem != "" {
+			var line BlameLine
+			var err error
+			if rem, line, err = blameLine(rem, commits); err != nil {
+				return rem, nil, ParserError{Err: err, Type: "blame_porcelain"}
+			}
+			lines = append(lines, line)
+		}
+
+		return rem, lines, nil
+	}
+}
+
+func blameLine(s string, commits map[string]BlameLine) (string, BlameLine, error) {
+	rem, header, err := Terminated(
+		All(
+			gitHash(),
+			Preceded(Tag(" "), While(IsDigit)),
+			Preceded(Tag(" "), While(IsDigit)),
+			Opt(Preceded(Tag(" "), While(IsDigit)))),
+		Crlf())(s)
+	if err != nil {
+		return s, BlameLine{}, err
+	}
+
+	line := commits[header[0]]
+	line.Hash = header[0]
+	line.OrigLine, _ = strconv.Atoi(header[1])
+	line.FinalLine, _ = strconv.Atoi(header[2])
+
+	var authorTime, authorTZ, committerTime, committerTZ string
+	for !strings.HasPrefix(rem, "\t") {
+		var kv []string
+		if rem, kv, err = Terminated(
+			Pair(While(isGitConfigName{}), Opt(Preceded(Tag(" "), WhileNotN(IsLineEnding, 0)))),
+			Crlf())(rem); err != nil {
+			return s, BlameLine{}, err
+		}
+
+		switch kv[0] {
+		case "author":
+			line.Author = kv[1]
+		case "author-mail":
+			line.AuthorMail = strings.TrimSuffix(strings.TrimPrefix(kv[1], "<"), ">")
+		case "author-time":
+			authorTime = kv[1]
+		case "author-tz":
+			authorTZ = kv[1]
+		case "committer":
+			line.Committer = kv[1]
+		case "committer-mail":
+			line.CommitterMail = strings.TrimSuffix(strings.TrimPrefix(kv[1], "<"), ">")
+		case "committer-time":
+			committerTime = kv[1]
+		case "committer-tz":
+			committerTZ = kv[1]
+		case "summary":
+			line.Summary = kv[1]
+		case "filename":
+			line.Filename = kv[1]
+		}
+	}
+
+	if authorTime != "" {
+		if line.AuthorTime, err = gitTime(authorTime, authorTZ); err != nil {
+			return s, BlameLine{}, err
+		}
+	}
+
+	if committerTime != "" {
+		if line.CommitterTime, err = gitTime(committerTime, committerTZ); err != nil {
+			return s, BlameLine{}, err
+		}
+	}
+
+	if rem, line.Content, err = Preceded(Tag("\t"), Eol())(rem); err != nil {
+		return s, BlameLine{}, err
+	}
+
+	commits[line.Hash] = line
+	return rem, line, nil
+}
+
+// gitTime converts a unix timestamp and a timezone offset, in the
+// format +HHMM, into a time within that timezone
+func gitTime(sec, tz string) (time.Time, error) {
+	rem, when, err := UnixTime()(sec)
+	if err != nil || rem != "" {
+		return time.Time{}, CombinatorParseError{Text: sec, Type: "git_time"}
+	}
+
+	if tz == "" {
+		return when, nil
+	}
+
+	rem, loc, err := TZOffset()(tz)
+	if err != nil || rem != "" || len(tz) != 5 {
+		return time.Time{}, CombinatorParseError{Text: tz, Type: "git_timezone"}
+	}
+
+	return when.In(loc), nil
+}
+
+// Ident contains the identity of either an author or committer within
+// a git commit.
+type Ident struct {
+	// Name of the identity.
+	Name string
+
+	// Email address of the identity, without any surrounding angle brackets.
+	Email string
+
+	// When the identity performed its action, within its timezone.
+	When time.Time
+}
+
+// Commit contains the parsed details of a raw git commit object.
+type Commit struct {
+	// Tree is the hash of the tree object associated with the commit.
+	Tree string
+
+	// Parents contains the hashes of any parent commits. An initial commit
+	// has no parents, while a merge commit has more than one.
+	Parents []string
+
+	// Author of the commit.
+	Author Ident
+
+	// Committer of the commit.
+	Committer Ident
+
+	// Headers contains any additional headers, such as gpgsig or encoding.
+	// The continuation lines of a multiline header are joined by a '\n'.
+	Headers map[string]string
+
+	// Message is the commit message, including its trailing line ending.
+	Message string
+}
+
+// CommitObject will parse a raw git commit object, as written by git cat-file
+// commit. A commit object starts with a series of headers, a tree, zero or more
+// parents, an author and a committer, each on a separate line. Any additional
+// headers are captured, with any continuation lines (prefixed by a space) being
+// joined. A blank line separates the headers from the commit message. The entire
+// input is parsed.
+//
+//	chomp.CommitObject()("tree 9bed...\nauthor purpleclay <purpleclay@github.com> 1704067200 +0100\ncommitter ...\n\nfeat: initial commit\n")
+//	// ("", chomp.Commit{Tree: "9bed...", Author: {Name: "purpleclay", ...}, Message: "feat: initial commit\n"}, nil)
+func CommitObject() Combinator[Commit] {
+	return func(s string) (string, Commit, error) {
+		var commit Commit
+
+		rem, tree, err := Delimited(Tag("tree "), gitHash(), Crlf())(s)
+		if err != nil {
+			return s, Commit{}, ParserError{Err: err, Type: "commit_object"}
+		}
+		commit.Tree = tree
+
+		if rem, commit.Parents, err = ManyN(Delimited(Tag("parent "), gitHash(), Crlf()), 0)(rem); err != nil {
+			return s, Commit{}, ParserError{Err: err, Type: "commit_object"}
+		}
+
+		if rem, commit.Author, err = Delimited(Tag("author "), IdentLine(), Crlf())(rem); err != nil {
+			return s, Commit{}, ParserError{Err: err, Type: "commit_object"}
+		}
+
+		if rem, commit.Committer, err = Delimited(Tag("committer "), IdentLine(), Crlf())(rem); err != nil {
+			return s, Commit{}, ParserError{Err: err, Type: "commit_object"}
+		}
+
+		for rem != "" {
+			var blank string
+			if blank, _, err = Crlf()(rem); err == nil {
+				rem = blank
+				break
+			}
+
+			var kv []string
+			if rem, kv, err = Terminated(SepPair(While(isGitConfigName{}), Tag(" "), WhileNotN(IsLineEnding, 0)), Opt(Crlf()))(rem); err != nil {
+				return s, Commit{}, ParserError{Err: err, Type: "commit_object"}
+			}
+
+			var cont []string
+			rem, cont, _ = ManyN(Delimited(Tag(" "), WhileNotN(IsLineEnding, 0), Opt(Crlf())), 0)(rem)
+
+			if commit.Headers == nil {
+				commit.Headers = map[string]string{}
+			}
+			commit.Headers[kv[0]] = strings.Join(append(kv[1:], cont...), "\n")
+		}
+
+		commit.Message = rem
+		return "", commit, nil
+	}
+}
+
+func gitHash() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, hash, err := While(isHexDigit{})(s)
+		if err != nil {
+			return rem, "", err
+		}
+
+		if len(hash) != 40 && len(hash) != 64 {
+			return s, "", CombinatorParseError{Text: s, Type: "git_hash"}
+		}
+
+		return rem, hash, nil
+	}
+}
+
+// IdentLine will parse a git identity, as written within the author and committer
+// lines of a commit, using the format Name <email> timestamp timezone. The unix
+// timestamp is resolved into a time within the timezone, such as +0100. The
+// email address is returned without its surrounding angle brackets.
+//
+//	chomp.IdentLine()("purpleclay <purpleclay@github.com> 1664450926 +0100")
+//	// ("", chomp.Ident{Name: "purpleclay", Email: "purpleclay@github.com", When: 2022-09-29T12:28:46+01:00}, nil)
+func IdentLine() Combinator[Ident] {
+	return func(s string) (string, Ident, error) {
+		rem, ext, err := All(
+			Until(" <"),
+			Preceded(Tag(" "), BracketAngled()),
+			Preceded(Tag(" "), While(IsDigit)),
+			Preceded(Tag(" "), WhileNot(IsLineEnding)))(s)
+		if err != nil {
+			return s, Ident{}, err
+		}
+
+		when, err := gitTime(ext[2], ext[3])
+		if err != nil {
+			return s, Ident{}, err
+		}
+
+		return rem, Ident{Name: ext[0], Email: ext[1], When: when}, nil
+	}
+}
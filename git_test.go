@@ -0,0 +1,513 @@
+package chomp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitConfig(t *testing.T) {
+	t.Parallel()
+
+	input := `# global settings
+[Core]
+	Bare = false
+	filemode   ; boolean shorthand
+	editor = "code --wait"  # VS Code
+[remote "origin"]
+	url = https://github.com/purpleclay/chomp
+	fetch = +refs/heads/*:refs/remotes/origin/*
+[alias]
+	lg = log --graph \
+	  --oneline
+	say = "!echo \"hello\tworld\""
+	fetch = first
+[ALIAS]
+	fetch = second
+`
+
+	rem, config, err := chomp.GitConfig()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, map[string]map[string]string{
+		"core": {
+			"bare":     "false",
+			"filemode": "true",
+			"editor":   "code --wait",
+		},
+		"remote.origin": {
+			"url":   "https://github.com/purpleclay/chomp",
+			"fetch": "+refs/heads/*:refs/remotes/origin/*",
+		},
+		"alias": {
+			"lg":    "log --graph    --oneline",
+			"say":   "!echo \"hello\tworld\"",
+			"fetch": "second",
+		},
+	}, config)
+}
+
+func TestGitConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "KeyOutsideSection",
+			input: "bare = false\n[core]",
+		},
+		{
+			name:  "UnterminatedQuote",
+			input: "[core]\n\teditor = \"vim\n",
+		},
+		{
+			name:  "UnknownEscape",
+			input: "[core]\n\teditor = vi\\m\n",
+		},
+		{
+			name:  "UnterminatedSection",
+			input: "[core\n\tbare = false\n",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, _, err := chomp.GitConfig()(tt.input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRefspec(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.RefspecParts
+	}{
+		{
+			name:     "ForcedWildcard",
+			input:    "+refs/heads/*:refs/remotes/origin/*",
+			expected: chomp.RefspecParts{Force: true, Src: "refs/heads/*", Dst: "refs/remotes/origin/*"},
+		},
+		{
+			name:     "SourceOnly",
+			input:    "refs/tags/v1.0.0",
+			expected: chomp.RefspecParts{Src: "refs/tags/v1.0.0"},
+		},
+		{
+			name:     "Delete",
+			input:    ":refs/heads/feature",
+			expected: chomp.RefspecParts{Dst: "refs/heads/feature"},
+		},
+		{
+			name:     "PartialWildcard",
+			input:    "refs/heads/feature-*:refs/remotes/origin/feature-*",
+			expected: chomp.RefspecParts{Src: "refs/heads/feature-*", Dst: "refs/remotes/origin/feature-*"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.Refspec()(tt.input)
+
+			require.NoError(t, err)
+			assert.Empty(t, rem)
+			assert.Equal(t, tt.expected, ext)
+		})
+	}
+}
+
+func TestRefspecStopsAtInvalidRefText(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Refspec()("main:main~1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "~1", rem)
+	assert.Equal(t, chomp.RefspecParts{Src: "main", Dst: "main"}, ext)
+}
+
+func TestRefspecInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Empty",
+			input: "+",
+		},
+		{
+			name:  "MismatchedWildcard",
+			input: "refs/heads/*:refs/remotes/origin/main",
+		},
+		{
+			name:  "MultipleWildcards",
+			input: "refs/*/heads/*:refs/*/remotes/*",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.Refspec()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestPorcelainStatus(t *testing.T) {
+	t.Parallel()
+
+	input := ` M README.md
+MM go.mod
+A  docs/guide.md
+R  old.go -> new.go
+RM "with space.go" -> "\343\201\223\343\202\223.go"
+?? "tab\there.txt"
+!! bin/
+`
+
+	rem, entries, err := chomp.PorcelainStatus()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, []chomp.StatusEntry{
+		{Staged: ' ', Unstaged: 'M', Path: "README.md"},
+		{Staged: 'M', Unstaged: 'M', Path: "go.mod"},
+		{Staged: 'A', Unstaged: ' ', Path: "docs/guide.md"},
+		{Staged: 'R', Unstaged: ' ', Path: "new.go", OrigPath: "old.go"},
+		{Staged: 'R', Unstaged: 'M', Path: "こん.go", OrigPath: "with space.go"},
+		{Staged: '?', Unstaged: '?', Path: "tab\there.txt"},
+		{Staged: '!', Unstaged: '!', Path: "bin/"},
+	}, entries)
+}
+
+func TestPorcelainStatusEmpty(t *testing.T) {
+	t.Parallel()
+
+	rem, entries, err := chomp.PorcelainStatus()("")
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Empty(t, entries)
+}
+
+func TestPorcelainStatusInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "UnknownStatusCode",
+			input: "XY README.md\n",
+		},
+		{
+			name:  "MissingPath",
+			input: " M \n",
+		},
+		{
+			name:  "RenameMissingArrow",
+			input: "R  old.go\n",
+		},
+		{
+			name:  "UnterminatedQuote",
+			input: "?? \"notes.txt\n",
+		},
+		{
+			name:  "InvalidEscape",
+			input: "?? \"\\9notes.txt\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := chomp.PorcelainStatus()(tt.input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestBlamePorcelain(t *testing.T) {
+	t.Parallel()
+
+	input := `8f2b1c0d6e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c 1 1 2
+author purpleclay
+author-mail <purpleclay@github.com>
+author-time 1704067200
+author-tz +0100
+committer GitHub
+committer-mail <noreply@github.com>
+committer-time 1704070800
+committer-tz -0500
+summary feat: initial commit
+boundary
+filename chomp.go
+	package chomp
+8f2b1c0d6e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c 2 2
+	
+0123456789abcdef0123456789abcdef01234567 5 3 1
+author octocat
+author-mail <octocat@github.com>
+author-time 1704153600
+author-tz +0000
+committer octocat
+committer-mail <octocat@github.com>
+committer-time 1704153600
+committer-tz +0000
+summary fix: typo
+previous 8f2b1c0d6e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c chomp.go
+filename chomp.go
+	import "strings"
+`
+
+	rem, lines, err := chomp.BlamePorcelain()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	require.Len(t, lines, 3)
+
+	assert.Equal(t, "8f2b1c0d6e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c", lines[0].Hash)
+	assert.Equal(t, 1, lines[0].OrigLine)
+	assert.Equal(t, 1, lines[0].FinalLine)
+	assert.Equal(t, "purpleclay", lines[0].Author)
+	assert.Equal(t, "purpleclay@github.com", lines[0].AuthorMail)
+	assert.Equal(t, "2024-01-01T01:00:00+01:00", lines[0].AuthorTime.Format(time.RFC3339))
+	assert.Equal(t, "GitHub", lines[0].Committer)
+	assert.Equal(t, "noreply@github.com", lines[0].CommitterMail)
+	assert.Equal(t, "2023-12-31T20:00:00-05:00", lines[0].CommitterTime.Format(time.RFC3339))
+	assert.Equal(t, "feat: initial commit", lines[0].Summary)
+	assert.Equal(t, "chomp.go", lines[0].Filename)
+	assert.Equal(t, "package chomp", lines[0].Content)
+
+	assert.Equal(t, lines[0].Hash, lines[1].Hash)
+	assert.Equal(t, 2, lines[1].OrigLine)
+	assert.Equal(t, 2, lines[1].FinalLine)
+	assert.Equal(t, "purpleclay", lines[1].Author)
+	assert.Equal(t, "chomp.go", lines[1].Filename)
+	assert.Empty(t, lines[1].Content)
+
+	assert.Equal(t, "0123456789abcdef0123456789abcdef01234567", lines[2].Hash)
+	assert.Equal(t, 5, lines[2].OrigLine)
+	assert.Equal(t, 3, lines[2].FinalLine)
+	assert.Equal(t, "octocat", lines[2].Author)
+	assert.Equal(t, "fix: typo", lines[2].Summary)
+	assert.Equal(t, `import "strings"`, lines[2].Content)
+}
+
+func TestBlamePorcelainInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "ShortHash",
+			input: "8f2b1c0 1 1 1\n\tpackage chomp\n",
+		},
+		{
+			name:  "MissingLineNumbers",
+			input: "8f2b1c0d6e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c\n\tpackage chomp\n",
+		},
+		{
+			name:  "InvalidTime",
+			input: "8f2b1c0d6e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c 1 1 1\nauthor-time yesterday\n\tpackage chomp\n",
+		},
+		{
+			name:  "InvalidTimezone",
+			input: "8f2b1c0d6e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c 1 1 1\nauthor-time 1704067200\nauthor-tz UTC\n\tpackage chomp\n",
+		},
+		{
+			name:  "MissingContent",
+			input: "8f2b1c0d6e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c 1 1 1\nauthor purpleclay\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := chomp.BlamePorcelain()(tt.input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCommitObject(t *testing.T) {
+	t.Parallel()
+
+	input := `tree 9bedf67800b2923982bdf60c89c57ce6b2e0e5f8
+parent 8f2b1c0d6e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c
+parent 0123456789abcdef0123456789abcdef01234567
+author purple clay <purpleclay@github.com> 1704067200 +0100
+committer GitHub <noreply@github.com> 1704070800 -0530
+gpgsig -----BEGIN PGP SIGNATURE-----
+ 
+ wsBcBAABCAAQBQJlkpC
+ -----END PGP SIGNATURE-----
+
+Merge branch 'main'
+
+Resolves conflicts.
+`
+
+	rem, commit, err := chomp.CommitObject()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, "9bedf67800b2923982bdf60c89c57ce6b2e0e5f8", commit.Tree)
+	assert.Equal(t, []string{
+		"8f2b1c0d6e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c",
+		"0123456789abcdef0123456789abcdef01234567",
+	}, commit.Parents)
+
+	assert.Equal(t, "purple clay", commit.Author.Name)
+	assert.Equal(t, "purpleclay@github.com", commit.Author.Email)
+	assert.Equal(t, "2024-01-01T01:00:00+01:00", commit.Author.When.Format(time.RFC3339))
+
+	assert.Equal(t, "GitHub", commit.Committer.Name)
+	assert.Equal(t, "noreply@github.com", commit.Committer.Email)
+	assert.Equal(t, "2023-12-31T19:30:00-05:30", commit.Committer.When.Format(time.RFC3339))
+
+	assert.Equal(t, map[string]string{
+		"gpgsig": "-----BEGIN PGP SIGNATURE-----\n\nwsBcBAABCAAQBQJlkpC\n-----END PGP SIGNATURE-----",
+	}, commit.Headers)
+	assert.Equal(t, "Merge branch 'main'\n\nResolves conflicts.\n", commit.Message)
+}
+
+func TestCommitObjectInitialCommit(t *testing.T) {
+	t.Parallel()
+
+	input := "tree 9bedf67800b2923982bdf60c89c57ce6b2e0e5f8\n" +
+		"author purpleclay <purpleclay@github.com> 1704067200 +0000\n" +
+		"committer purpleclay <purpleclay@github.com> 1704067200 +0000\n" +
+		"\n" +
+		"feat: initial commit\n"
+
+	rem, commit, err := chomp.CommitObject()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Empty(t, commit.Parents)
+	assert.Empty(t, commit.Headers)
+	assert.Equal(t, "feat: initial commit\n", commit.Message)
+}
+
+func TestCommitObjectInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "MissingTree",
+			input: "author purpleclay <purpleclay@github.com> 1704067200 +0000\n",
+		},
+		{
+			name:  "InvalidParentHash",
+			input: "tree 9bedf67800b2923982bdf60c89c57ce6b2e0e5f8\nparent 8f2b1c0\n",
+		},
+		{
+			name: "MissingEmail",
+			input: "tree 9bedf67800b2923982bdf60c89c57ce6b2e0e5f8\n" +
+				"author purpleclay 1704067200 +0000\n",
+		},
+		{
+			name: "InvalidTimezone",
+			input: "tree 9bedf67800b2923982bdf60c89c57ce6b2e0e5f8\n" +
+				"author purpleclay <purpleclay@github.com> 1704067200 BST\n",
+		},
+		{
+			name: "MissingCommitter",
+			input: "tree 9bedf67800b2923982bdf60c89c57ce6b2e0e5f8\n" +
+				"author purpleclay <purpleclay@github.com> 1704067200 +0000\n" +
+				"\n" +
+				"feat: initial commit\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := chomp.CommitObject()(tt.input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestIdentLine(t *testing.T) {
+	t.Parallel()
+
+	rem, ident, err := chomp.IdentLine()("purple clay <purpleclay@github.com> 1664450926 +0100\nmore")
+
+	require.NoError(t, err)
+	assert.Equal(t, "\nmore", rem)
+	assert.Equal(t, "purple clay", ident.Name)
+	assert.Equal(t, "purpleclay@github.com", ident.Email)
+	assert.Equal(t, "2022-09-29T12:28:46+01:00", ident.When.Format(time.RFC3339))
+}
+
+func TestIdentLineInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "MissingEmail",
+			input: "purpleclay 1664450926 +0100",
+		},
+		{
+			name:  "MissingTimestamp",
+			input: "purpleclay <purpleclay@github.com> +0100",
+		},
+		{
+			name:  "MissingTimezone",
+			input: "purpleclay <purpleclay@github.com> 1664450926",
+		},
+		{
+			name:  "InvalidTimezone",
+			input: "purpleclay <purpleclay@github.com> 1664450926 +01:00",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.IdentLine()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/purpleclay/chomp
 
-go 1.19
+go 1.20
 
 require github.com/stretchr/testify v1.9.0
 
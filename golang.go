@@ -0,0 +1,425 @@
+package chomp
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ImportSpecParts contains the individual parts of a parsed Go import spec.
+type ImportSpecParts struct {
+	// Alias of the imported package, if any. Special aliases, _ (blank import)
+	// and . (dot import), are returned as is.
+	Alias string
+
+	// Path of the imported package, without its surrounding quotes.
+	Path string
+}
+
+// ImportSpec will parse a single Go import spec, consisting of an optional
+// alias followed by a quoted import path, alias "path/to/pkg". An alias must
+// either be a valid Go identifier, or one of the special aliases _ or '.'.
+// The import path can either be an interpreted ("...") or raw (`...`) string
+// literal, and is unquoted.
+//
+//	chomp.ImportSpec()(`yaml "gopkg.in/yaml.v3"`)
+//	// ("", chomp.ImportSpecParts{Alias: "yaml", Path: "gopkg.in/yaml.v3"}, nil)
+func ImportSpec() Combinator[ImportSpecParts] {
+	return func(s string) (string, ImportSpecParts, error) {
+		var spec ImportSpecParts
+
+		rem := s
+		if rem == "" || (rem[0] != '"' && rem[0] != '`') {
+			var err error
+			if rem, spec.Alias, err = First(Tag("."), Identifier())(rem); err != nil {
+				return s, ImportSpecParts{}, ParserError{
+					Err:  CombinatorParseError{Text: s, Type: "import_alias"},
+					Type: "import_spec",
+				}
+			}
+			rem, _, _ = WhileN(isBlank{}, 0)(rem)
+		}
+
+		lit := rem
+		rem, path, err := goStringLiteral(lit)
+		if err != nil || path == "" {
+			return s, ImportSpecParts{}, ParserError{
+				Err:  CombinatorParseError{Text: lit, Type: "import_path"},
+				Type: "import_spec",
+			}
+		}
+		spec.Path = path
+
+		return rem, spec, nil
+	}
+}
+
+func goStringLiteral(s string) (string, string, error) {
+	switch {
+	case len(s) > 0 && s[0] == '`':
+		return Delimited(Tag("`"), Until("`"), Tag("`"))(s)
+	case len(s) > 0 && s[0] == '"':
+		rem, lit, err := EscapedString('"', '\\')(s)
+		if err != nil {
+			return s, "", err
+		}
+
+		if lit, err = strconv.Unquote(`"` + lit + `"`); err != nil {
+			return s, "", ParserError{Err: err, Type: "go_string_literal"}
+		}
+		return rem, lit, nil
+	}
+
+	return s, "", CombinatorParseError{Text: s, Type: "go_string_literal"}
+}
+
+// BuildExpr is a boolean expression parsed from a Go build constraint.
+type BuildExpr interface {
+	// Eval reports whether the expression evaluates to true, using ok to
+	// determine whether each build tag is satisfied.
+	Eval(ok func(tag string) bool) bool
+
+	// String returns the expression using Go build constraint syntax.
+	String() string
+}
+
+// BuildTag is a single build tag, such as linux or go1.21.
+type BuildTag struct {
+	Tag string
+}
+
+// BuildNot negates an expression, !X.
+type BuildNot struct {
+	X BuildExpr
+}
+
+// BuildAnd is the conjunction of two expressions, X && Y.
+type BuildAnd struct {
+	X, Y BuildExpr
+}
+
+// BuildOr is the disjunction of two expressions, X || Y.
+type BuildOr struct {
+	X, Y BuildExpr
+}
+
+// Eval reports whether the build tag is satisfied.
+func (e BuildTag) Eval(ok func(tag string) bool) bool { return ok(e.Tag) }
+
+// Eval reports whether the negated expression is false.
+func (e BuildNot) Eval(ok func(tag string) bool) bool { return !e.X.Eval(ok) }
+
+// Eval reports whether both expressions are true.
+func (e BuildAnd) Eval(ok func(tag string) bool) bool { return e.X.Eval(ok) && e.Y.Eval(ok) }
+
+// Eval reports whether either expression is true.
+func (e BuildOr) Eval(ok func(tag string) bool) bool { return e.X.Eval(ok) || e.Y.Eval(ok) }
+
+// String returns the build tag.
+func (e BuildTag) String() string { return e.Tag }
+
+// String returns the negated expression, parenthesizing it if needed.
+func (e BuildNot) String() string { return "!" + buildOperand(e.X, true) }
+
+// String returns the conjunction, parenthesizing any disjunctions.
+func (e BuildAnd) String() string {
+	return buildOperand(e.X, false) + " && " + buildOperand(e.Y, false)
+}
+
+// String returns the disjunction.
+func (e BuildOr) String() string { return e.X.String() + " || " + e.Y.String() }
+
+func buildOperand(e BuildExpr, unary bool) string {
+	switch e.(type) {
+	case BuildOr:
+		return "(" + e.String() + ")"
+	case BuildAnd:
+		if unary {
+			return "(" + e.String() + ")"
+		}
+	}
+	return e.String()
+}
+
+type isBuildTag struct{}
+
+func (isBuildTag) Match(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.'
+}
+
+func (isBuildTag) String() string {
+	return "is_build_tag"
+}
+
+// BuildConstraint will parse a Go build constraint line, //go:build, into a
+// boolean expression. An expression is made up of build tags combined using
+// the operators ! (not), && (and) and || (or), in order of precedence. Parentheses
+// can be used to group expressions. The line ending is consumed.
+//
+//	chomp.BuildConstraint()("//go:build linux && (amd64 || arm64) && !cgo\n")
+//	// ("", chomp.BuildAnd{X: chomp.BuildAnd{X: ..., Y: chomp.BuildOr{...}}, Y: chomp.BuildNot{...}}, nil)
+func BuildConstraint() Combinator[BuildExpr] {
+	return func(s string) (string, BuildExpr, error) {
+		rem, _, err := Terminated(Tag("//go:build"), While(isBlank{}))(s)
+		if err != nil {
+			return s, nil, ParserError{Err: err, Type: "build_constraint"}
+		}
+
+		rem, expr, err := buildOr(rem)
+		if err != nil {
+			return s, nil, ParserError{Err: err, Type: "build_constraint"}
+		}
+
+		if rem != "" {
+			if rem, _, err = Crlf()(rem); err != nil {
+				return s, nil, ParserError{Err: err, Type: "build_constraint"}
+			}
+		}
+
+		return rem, expr, nil
+	}
+}
+
+func buildOr(s string) (string, BuildExpr, error) {
+	rem, x, err := buildAnd(s)
+	if err != nil {
+		return s, nil, err
+	}
+
+	for {
+		next, _, err := Terminated(Tag("||"), WhileN(isBlank{}, 0))(rem)
+		if err != nil {
+			return rem, x, nil
+		}
+
+		var y BuildExpr
+		if rem, y, err = buildAnd(next); err != nil {
+			return s, nil, err
+		}
+		x = BuildOr{X: x, Y: y}
+	}
+}
+
+func buildAnd(s string) (string, BuildExpr, error) {
+	rem, x, err := buildNot(s)
+	if err != nil {
+		return s, nil, err
+	}
+
+	for {
+		next, _, err := Terminated(Tag("&&"), WhileN(isBlank{}, 0))(rem)
+		if err != nil {
+			return rem, x, nil
+		}
+
+		var y BuildExpr
+		if rem, y, err = buildNot(next); err != nil {
+			return s, nil, err
+		}
+		x = BuildAnd{X: x, Y: y}
+	}
+}
+
+func buildNot(s string) (string, BuildExpr, error) {
+	if rem, _, err := Terminated(Tag("!"), WhileN(isBlank{}, 0))(s); err == nil {
+		rem, x, err := buildNot(rem)
+		if err != nil {
+			return s, nil, err
+		}
+		return rem, BuildNot{X: x}, nil
+	}
+
+	if rem, _, err := Terminated(Tag("("), WhileN(isBlank{}, 0))(s); err == nil {
+		rem, x, err := buildOr(rem)
+		if err != nil {
+			return s, nil, err
+		}
+
+		if rem, _, err = Terminated(Tag(")"), WhileN(isBlank{}, 0))(rem); err != nil {
+			return s, nil, err
+		}
+		return rem, x, nil
+	}
+
+	rem, tag, err := Terminated(While(isBuildTag{}), WhileN(isBlank{}, 0))(s)
+	if err != nil {
+		return s, nil, err
+	}
+
+	return rem, BuildTag{Tag: tag}, nil
+}
+
+// GoModDirective contains the parsed details of a single directive from
+// a go.mod file. The fields that are set depend on its Verb.
+type GoModDirective struct {
+	// Verb of the directive, either require, replace, exclude or retract.
+	// It is empty when parsing a line from within a block, as the verb is
+	// declared by the block itself.
+	Verb string
+
+	// Path of the module. Not set for retract.
+	Path string
+
+	// Version of the module. For retract, this is either the retracted
+	// version, or the lower bound of a retracted range. For replace, it is
+	// optional and only set if a specific version is replaced.
+	Version string
+
+	// RangeEnd is the upper bound of a retracted range, [low, high]. Only
+	// set for retract.
+	RangeEnd string
+
+	// ReplacePath is the path of the replacement module, or a local directory.
+	// Only set for replace.
+	ReplacePath string
+
+	// ReplaceVersion is the version of the replacement module. It is not set
+	// if the replacement is a local directory. Only set for replace.
+	ReplaceVersion string
+
+	// Indirect is true if the directive was marked with an // indirect comment.
+	Indirect bool
+
+	// Comment contains any trailing comment, without its leading //. An
+	// // indirect comment is not included.
+	Comment string
+}
+
+var goModVerbs = map[string]bool{"require": true, "replace": true, "exclude": true, "retract": true}
+
+// GoModLine will parse a single require, replace, exclude or retract directive
+// from a go.mod file. Both the single line form, require example.com/mod v1.2.3,
+// and the lines from within a block, example.com/mod v1.2.3, are supported,
+// but any block wrappers, require (...), are not. When parsing a line from within
+// a block, the shape of the line determines the fields that are set:
+//   - path version, for require and exclude
+//   - path [version] => path [version], for replace
+//   - version or [low, high], for retract
+//
+// A trailing comment is captured, with // indirect being reported separately.
+// Paths and versions can be quoted. The line ending is consumed.
+//
+//	chomp.GoModLine()("require github.com/stretchr/testify v1.9.0 // indirect\n")
+//	// ("", chomp.GoModDirective{Verb: "require", Path: "github.com/stretchr/testify", Version: "v1.9.0", Indirect: true}, nil)
+func GoModLine() Combinator[GoModDirective] {
+	return func(s string) (string, GoModDirective, error) {
+		var dir GoModDirective
+
+		rem, _, _ := WhileN(isBlank{}, 0)(s)
+		if next, verb, err := Terminated(While(IsLetter), While(isBlank{}))(rem); err == nil && goModVerbs[verb] {
+			rem = next
+			dir.Verb = verb
+		}
+
+		text := firstLine(rem)
+		rem = rem[len(text):]
+
+		line, comment, _ := strings.Cut(text, "//")
+
+		if comment = strings.TrimSpace(comment); comment == "indirect" {
+			dir.Indirect = true
+		} else {
+			dir.Comment = comment
+		}
+
+		if err := goModFields(strings.TrimSpace(line), &dir); err != nil {
+			return s, GoModDirective{}, ParserError{Err: err, Type: "go_mod_line"}
+		}
+
+		if rem != "" {
+			rem, _, _ = Crlf()(rem)
+		}
+
+		return rem, dir, nil
+	}
+}
+
+func firstLine(s string) string {
+	if idx := strings.IndexAny(s, "\r\n"); idx != -1 {
+		return s[:idx]
+	}
+	return s
+}
+
+func goModFields(line string, dir *GoModDirective) error {
+	if strings.HasPrefix(line, "[") {
+		if dir.Verb != "" && dir.Verb != "retract" {
+			return CombinatorParseError{Text: line, Type: "go_mod_fields"}
+		}
+
+		rem, ext, err := Delimited(
+			Terminated(Tag("["), Spaces0()),
+			SepPair(goModToken(), Delimited(Spaces0(), Tag(","), Spaces0()), goModToken()),
+			Preceded(Spaces0(), Tag("]")))(line)
+		if err != nil || rem != "" {
+			return CombinatorParseError{Text: line, Type: "go_mod_retract_range"}
+		}
+
+		dir.Version, dir.RangeEnd = ext[0], ext[1]
+		return nil
+	}
+
+	var tokens []string
+	rem := line
+	for rem != "" {
+		var tok string
+		var err error
+		if rem, tok, err = Terminated(First(Tag("=>"), goModToken()), Spaces0())(rem); err != nil {
+			return err
+		}
+		tokens = append(tokens, tok)
+	}
+
+	arrow := -1
+	for i, tok := range tokens {
+		if tok == "=>" {
+			arrow = i
+		}
+	}
+
+	switch {
+	case arrow != -1 && (dir.Verb == "" || dir.Verb == "replace"):
+		old, replacement := tokens[:arrow], tokens[arrow+1:]
+		if len(old) < 1 || len(old) > 2 || len(replacement) < 1 || len(replacement) > 2 {
+			return CombinatorParseError{Text: line, Type: "go_mod_replace"}
+		}
+
+		dir.Path = old[0]
+		if len(old) == 2 {
+			dir.Version = old[1]
+		}
+
+		dir.ReplacePath = replacement[0]
+		if len(replacement) == 2 {
+			dir.ReplaceVersion = replacement[1]
+		}
+	case len(tokens) == 1 && (dir.Verb == "" || dir.Verb == "retract"):
+		dir.Version = tokens[0]
+	case len(tokens) == 2 && arrow == -1 && dir.Verb != "replace" && dir.Verb != "retract":
+		dir.Path, dir.Version = tokens[0], tokens[1]
+	default:
+		return CombinatorParseError{Text: line, Type: "go_mod_fields"}
+	}
+
+	return nil
+}
+
+type isGoModToken struct{}
+
+func (isGoModToken) Match(r rune) bool {
+	return !unicode.IsSpace(r) && r != '"' && r != '`' && r != ',' && r != '[' && r != ']'
+}
+
+func (isGoModToken) String() string {
+	return "is_go_mod_token"
+}
+
+func goModToken() Combinator[string] {
+	return func(s string) (string, string, error) {
+		if rem, tok, err := goStringLiteral(s); err == nil {
+			return rem, tok, nil
+		}
+
+		return While(isGoModToken{})(s)
+	}
+}
@@ -0,0 +1,362 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportSpec(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.ImportSpecParts
+	}{
+		{
+			name:     "Bare",
+			input:    `"github.com/purpleclay/chomp"`,
+			expected: chomp.ImportSpecParts{Path: "github.com/purpleclay/chomp"},
+		},
+		{
+			name:     "Alias",
+			input:    `yaml "gopkg.in/yaml.v3"`,
+			expected: chomp.ImportSpecParts{Alias: "yaml", Path: "gopkg.in/yaml.v3"},
+		},
+		{
+			name:     "BlankImport",
+			input:    `_ "embed"`,
+			expected: chomp.ImportSpecParts{Alias: "_", Path: "embed"},
+		},
+		{
+			name:     "DotImport",
+			input:    `. "github.com/onsi/gomega"`,
+			expected: chomp.ImportSpecParts{Alias: ".", Path: "github.com/onsi/gomega"},
+		},
+		{
+			name:     "UnicodeAlias",
+			input:    `ß1 "strings"`,
+			expected: chomp.ImportSpecParts{Alias: "ß1", Path: "strings"},
+		},
+		{
+			name:     "RawString",
+			input:    "str `strings`",
+			expected: chomp.ImportSpecParts{Alias: "str", Path: "strings"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, spec, err := chomp.ImportSpec()(tt.input + " // comment")
+
+			require.NoError(t, err)
+			assert.Equal(t, " // comment", rem)
+			assert.Equal(t, tt.expected, spec)
+		})
+	}
+}
+
+func TestImportSpecInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "MalformedAlias",
+			input: `1yaml "gopkg.in/yaml.v3"`,
+			err:   `(import_spec) parser failed. (import_alias) combinator failed to parse text '1yaml "gopkg.in/yaml.v3"'`,
+		},
+		{
+			name:  "MissingPath",
+			input: `yaml`,
+			err:   `(import_spec) parser failed. (import_path) combinator failed to parse text ''`,
+		},
+		{
+			name:  "UnquotedPath",
+			input: `yaml gopkg.in/yaml.v3`,
+			err:   `(import_spec) parser failed. (import_path) combinator failed to parse text 'gopkg.in/yaml.v3'`,
+		},
+		{
+			name:  "EmptyPath",
+			input: `yaml ""`,
+			err:   `(import_spec) parser failed. (import_path) combinator failed to parse text '""'`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.ImportSpec()(tt.input)
+
+			require.EqualError(t, err, tt.err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestBuildConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.BuildExpr
+		str      string
+	}{
+		{
+			name:     "Tag",
+			input:    "//go:build linux\n",
+			expected: chomp.BuildTag{Tag: "linux"},
+			str:      "linux",
+		},
+		{
+			name:  "Precedence",
+			input: "//go:build linux && amd64 || !cgo\n",
+			expected: chomp.BuildOr{
+				X: chomp.BuildAnd{X: chomp.BuildTag{Tag: "linux"}, Y: chomp.BuildTag{Tag: "amd64"}},
+				Y: chomp.BuildNot{X: chomp.BuildTag{Tag: "cgo"}},
+			},
+			str: "linux && amd64 || !cgo",
+		},
+		{
+			name:  "Parentheses",
+			input: "//go:build linux&&(amd64||arm64) && !(cgo && go1.21)\n",
+			expected: chomp.BuildAnd{
+				X: chomp.BuildAnd{
+					X: chomp.BuildTag{Tag: "linux"},
+					Y: chomp.BuildOr{X: chomp.BuildTag{Tag: "amd64"}, Y: chomp.BuildTag{Tag: "arm64"}},
+				},
+				Y: chomp.BuildNot{X: chomp.BuildAnd{X: chomp.BuildTag{Tag: "cgo"}, Y: chomp.BuildTag{Tag: "go1.21"}}},
+			},
+			str: "linux && (amd64 || arm64) && !(cgo && go1.21)",
+		},
+		{
+			name:     "NoLineEnding",
+			input:    "//go:build !windows",
+			expected: chomp.BuildNot{X: chomp.BuildTag{Tag: "windows"}},
+			str:      "!windows",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, expr, err := chomp.BuildConstraint()(tt.input)
+
+			require.NoError(t, err)
+			assert.Empty(t, rem)
+			assert.Equal(t, tt.expected, expr)
+			assert.Equal(t, tt.str, expr.String())
+		})
+	}
+}
+
+func TestBuildConstraintEval(t *testing.T) {
+	t.Parallel()
+
+	_, expr, err := chomp.BuildConstraint()("//go:build linux && (amd64 || arm64) && !cgo")
+	require.NoError(t, err)
+
+	tags := func(set ...string) func(string) bool {
+		return func(tag string) bool {
+			for _, s := range set {
+				if s == tag {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	assert.True(t, expr.Eval(tags("linux", "arm64")))
+	assert.False(t, expr.Eval(tags("linux", "arm64", "cgo")))
+	assert.False(t, expr.Eval(tags("darwin", "amd64")))
+}
+
+func TestBuildConstraintInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "LegacySyntax",
+			input: "// +build linux\n",
+		},
+		{
+			name:  "MissingSpace",
+			input: "//go:buildlinux\n",
+		},
+		{
+			name:  "UnbalancedParentheses",
+			input: "//go:build (linux || darwin\n",
+		},
+		{
+			name:  "DanglingOperator",
+			input: "//go:build linux &&\n",
+		},
+		{
+			name:  "UnknownOperator",
+			input: "//go:build linux & amd64\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.BuildConstraint()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestGoModLine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.GoModDirective
+	}{
+		{
+			name:  "Require",
+			input: "require github.com/stretchr/testify v1.9.0\n",
+			expected: chomp.GoModDirective{
+				Verb:    "require",
+				Path:    "github.com/stretchr/testify",
+				Version: "v1.9.0",
+			},
+		},
+		{
+			name:  "RequireIndirect",
+			input: "\tgithub.com/davecgh/go-spew v1.1.1 // indirect\n",
+			expected: chomp.GoModDirective{
+				Path:     "github.com/davecgh/go-spew",
+				Version:  "v1.1.1",
+				Indirect: true,
+			},
+		},
+		{
+			name:  "Exclude",
+			input: `exclude "golang.org/x/net" v0.1.0` + "\n",
+			expected: chomp.GoModDirective{
+				Verb:    "exclude",
+				Path:    "golang.org/x/net",
+				Version: "v0.1.0",
+			},
+		},
+		{
+			name:  "ReplaceLocal",
+			input: "replace github.com/purpleclay/chomp => ../chomp\n",
+			expected: chomp.GoModDirective{
+				Verb:        "replace",
+				Path:        "github.com/purpleclay/chomp",
+				ReplacePath: "../chomp",
+			},
+		},
+		{
+			name:  "ReplaceVersion",
+			input: "\tgolang.org/x/net v1.2.3 => example.com/fork/net v1.4.5\n",
+			expected: chomp.GoModDirective{
+				Path:           "golang.org/x/net",
+				Version:        "v1.2.3",
+				ReplacePath:    "example.com/fork/net",
+				ReplaceVersion: "v1.4.5",
+			},
+		},
+		{
+			name:  "Retract",
+			input: "retract v1.0.0 // published accidentally\n",
+			expected: chomp.GoModDirective{
+				Verb:    "retract",
+				Version: "v1.0.0",
+				Comment: "published accidentally",
+			},
+		},
+		{
+			name:  "RetractRange",
+			input: "\t[v1.0.0, v1.9.9]\n",
+			expected: chomp.GoModDirective{
+				Version:  "v1.0.0",
+				RangeEnd: "v1.9.9",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, dir, err := chomp.GoModLine()(tt.input + "go 1.22\n")
+
+			require.NoError(t, err)
+			assert.Equal(t, "go 1.22\n", rem)
+			assert.Equal(t, tt.expected, dir)
+		})
+	}
+}
+
+func TestGoModLineInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "RequireMissingVersion",
+			input: "require github.com/stretchr/testify\n",
+		},
+		{
+			name:  "ReplaceMissingArrow",
+			input: "replace github.com/purpleclay/chomp ../chomp\n",
+		},
+		{
+			name:  "ReplaceMissingTarget",
+			input: "replace github.com/purpleclay/chomp =>\n",
+		},
+		{
+			name:  "RetractUnclosedRange",
+			input: "retract [v1.0.0, v1.9.9\n",
+		},
+		{
+			name:  "RequireRange",
+			input: "require [v1.0.0, v1.9.9]\n",
+		},
+		{
+			name:  "TooManyFields",
+			input: "github.com/stretchr/testify v1.9.0 v2.0.0\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.GoModLine()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
@@ -0,0 +1,563 @@
+package chomp
+
+import (
+	"net/url"
+	"strings"
+)
+
+type isHTTPToken struct{}
+
+func (isHTTPToken) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+		strings.ContainsRune("!#$%&'*+-.^_`|~", r)
+}
+
+func (isHTTPToken) String() string {
+	return "is_http_token"
+}
+
+// httpQuotedString matches an HTTP quoted-string, returning its value with
+// any quoted-pairs (\") unescaped
+func httpQuotedString() Combinator[string] {
+	return EscapedTransform('"', '\\', func(r rune) (string, error) {
+		return string(r), nil
+	})
+}
+
+// httpParam matches a single parameter, key=value, where a value is either
+// a token or a quoted-string
+func httpParam() Combinator[[]string] {
+	return SepPair(
+		While(isHTTPToken{}),
+		Delimited(WhileN(isBlank{}, 0), Tag("="), WhileN(isBlank{}, 0)),
+		First(httpQuotedString(), While(isHTTPToken{})))
+}
+
+// httpParams matches a list of semicolon separated parameters, ; key=value,
+// where a value is either a token or a quoted-string. Keys are normalized to
+// lowercase. Extended parameters (key*), as defined by RFC 5987, are decoded
+// and stored against their key without the trailing '*', taking precedence
+// over any plain parameter of the same name
+func httpParams(s string) (string, map[string]string, error) {
+	params := map[string]string{}
+	extended := map[string]bool{}
+
+	rem := s
+	for {
+		next, _, err := Delimited(WhileN(isBlank{}, 0), Tag(";"), WhileN(isBlank{}, 0))(rem)
+		if err != nil {
+			return rem, params, nil
+		}
+
+		var kv []string
+		if next, kv, err = httpParam()(next); err != nil {
+			return s, nil, err
+		}
+		rem = next
+
+		key := strings.ToLower(kv[0])
+		if strings.HasSuffix(key, "*") {
+			key = strings.TrimSuffix(key, "*")
+
+			var ext ExtValueParts
+			if _, ext, err = AllConsuming(ExtValue())(kv[1]); err != nil {
+				return s, nil, err
+			}
+			params[key] = ext.Value
+			extended[key] = true
+			continue
+		}
+
+		if !extended[key] {
+			params[key] = kv[1]
+		}
+	}
+}
+
+type isMIMECharset struct{}
+
+func (isMIMECharset) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+		strings.ContainsRune("!#$%&+-^_`{}~", r)
+}
+
+func (isMIMECharset) String() string {
+	return "is_mime_charset"
+}
+
+type isLanguageTag struct{}
+
+func (isLanguageTag) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-'
+}
+
+func (isLanguageTag) String() string {
+	return "is_language_tag"
+}
+
+type isExtValueChar struct{}
+
+func (isExtValueChar) Match(r rune) bool {
+	return r != '\'' && r != '*' && isHTTPToken{}.Match(r)
+}
+
+func (isExtValueChar) String() string {
+	return "is_ext_value_char"
+}
+
+// ExtValueParts contains the individual parts of a parsed RFC 5987
+// extended value.
+type ExtValueParts struct {
+	// Charset used to encode the value. It is normalized to uppercase.
+	Charset string
+
+	// Lang is the optional language tag of the value.
+	Lang string
+
+	// Value is the percent-decoded value.
+	Value string
+}
+
+// ExtValue will parse an RFC 5987 extended value, charset'language'value,
+// as used by extended parameters within HTTP headers, such as filename*.
+// The language is optional. The value is percent-decoded using the declared
+// charset, which must be either UTF-8 or ISO-8859-1.
+//
+//	chomp.ExtValue()("UTF-8'en'%E2%82%AC%20rates")
+//	// ("", chomp.ExtValueParts{Charset: "UTF-8", Lang: "en", Value: "€ rates"}, nil)
+func ExtValue() Combinator[ExtValueParts] {
+	return func(s string) (string, ExtValueParts, error) {
+		rem, ext, err := All(
+			While(isMIMECharset{}),
+			Tag("'"),
+			WhileN(isLanguageTag{}, 0),
+			Tag("'"),
+			WhileN(isExtValueChar{}, 0))(s)
+		if err != nil {
+			return s, ExtValueParts{}, ParserError{Err: err, Type: "ext_value"}
+		}
+
+		value, err := url.PathUnescape(ext[4])
+		if err != nil {
+			return s, ExtValueParts{}, ParserError{Err: err, Type: "ext_value"}
+		}
+
+		charset := strings.ToUpper(ext[0])
+		switch charset {
+		case "UTF-8":
+		case "ISO-8859-1":
+			runes := make([]rune, 0, len(value))
+			for i := 0; i < len(value); i++ {
+				runes = append(runes, rune(value[i]))
+			}
+			value = string(runes)
+		default:
+			return s, ExtValueParts{}, CombinatorParseError{Input: ext[0], Text: s, Type: "ext_value_charset"}
+		}
+
+		return rem, ExtValueParts{Charset: charset, Lang: ext[2], Value: value}, nil
+	}
+}
+
+// ContentDispositionParts contains the individual parts of a parsed
+// Content-Disposition header.
+type ContentDispositionParts struct {
+	// Type of disposition, such as inline or attachment. It is normalized
+	// to lowercase.
+	Type string
+
+	// Params contains any parameters, such as filename. Keys are normalized
+	// to lowercase and values are unquoted.
+	Params map[string]string
+}
+
+// ContentDisposition will parse the value of a Content-Disposition header, a
+// disposition type followed by any number of parameters, type; key=value. A
+// parameter value can either be a token or a quoted-string. Extended parameters,
+// such as filename*=UTF-8'en'na%C3%AFve.txt, are decoded as defined by RFC 5987 and
+// stored against their name without the trailing '*'. As recommended by RFC 6266,
+// an extended parameter takes precedence over a plain parameter of the same name.
+//
+//	chomp.ContentDisposition()(`attachment; filename="foo.txt"; filename*=UTF-8''na%C3%AFve.txt`)
+//	// ("", chomp.ContentDispositionParts{Type: "attachment", Params: {"filename": "naïve.txt"}}, nil)
+func ContentDisposition() Combinator[ContentDispositionParts] {
+	return func(s string) (string, ContentDispositionParts, error) {
+		rem, typ, err := While(isHTTPToken{})(s)
+		if err != nil {
+			return s, ContentDispositionParts{}, ParserError{Err: err, Type: "content_disposition"}
+		}
+
+		rem, params, err := httpParams(rem)
+		if err != nil {
+			return s, ContentDispositionParts{}, ParserError{Err: err, Type: "content_disposition"}
+		}
+
+		return rem, ContentDispositionParts{Type: strings.ToLower(typ), Params: params}, nil
+	}
+}
+
+type isToken68 struct{}
+
+func (isToken68) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+		strings.ContainsRune("-._~+/", r)
+}
+
+func (isToken68) String() string {
+	return "is_token68"
+}
+
+// AuthChallengeParts contains the individual parts of a parsed
+// authentication challenge.
+type AuthChallengeParts struct {
+	// Scheme is the authentication scheme, such as basic or bearer. It is
+	// normalized to lowercase.
+	Scheme string
+
+	// Params contains any parameters of the challenge, such as realm. Keys
+	// are normalized to lowercase and values are unquoted.
+	Params map[string]string
+
+	// Token68 contains an opaque token provided in place of any parameters.
+	Token68 string
+}
+
+// AuthChallenge will parse the value of a WWW-Authenticate or Proxy-Authenticate
+// header, a comma separated list of one or more challenges. Each challenge is an
+// authentication scheme, optionally followed by either a token68 or a comma
+// separated list of parameters, key=value. As a comma also separates challenges,
+// a parameter list ends at the first comma not followed by another parameter.
+//
+//	chomp.AuthChallenge()(`Bearer realm="api", error="invalid_token", Basic realm="web"`)
+//	// ("", []chomp.AuthChallengeParts{
+//	//   {Scheme: "bearer", Params: {"realm": "api", "error": "invalid_token"}},
+//	//   {Scheme: "basic", Params: {"realm": "web"}}}, nil)
+func AuthChallenge() Combinator[[]AuthChallengeParts] {
+	return func(s string) (string, []AuthChallengeParts, error) {
+		rem, challenge, err := authChallenge(s)
+		if err != nil {
+			return s, nil, ParserError{Err: err, Type: "auth_challenge"}
+		}
+		challenges := []AuthChallengeParts{challenge}
+
+		for {
+			next, _, err := httpListSep()(rem)
+			if err != nil {
+				break
+			}
+
+			if next, challenge, err = authChallenge(next); err != nil {
+				break
+			}
+			challenges = append(challenges, challenge)
+			rem = next
+		}
+
+		return rem, challenges, nil
+	}
+}
+
+// httpListSep matches a comma separating elements within an HTTP list,
+// along with any surrounding whitespace
+func httpListSep() Combinator[string] {
+	return Delimited(WhileN(isBlank{}, 0), Tag(","), WhileN(isBlank{}, 0))
+}
+
+func authChallenge(s string) (string, AuthChallengeParts, error) {
+	rem, scheme, err := While(isHTTPToken{})(s)
+	if err != nil {
+		return s, AuthChallengeParts{}, err
+	}
+
+	challenge := AuthChallengeParts{Scheme: strings.ToLower(scheme), Params: map[string]string{}}
+
+	after, _, err := While(isBlank{})(rem)
+	if err != nil {
+		return rem, challenge, nil
+	}
+
+	next, kv, err := httpParam()(after)
+	if err != nil {
+		next, token, err := While(isToken68{})(after)
+		if err != nil {
+			return rem, challenge, nil
+		}
+
+		padding := len(next) - len(strings.TrimLeft(next, "="))
+		challenge.Token68 = token + next[:padding]
+		return next[padding:], challenge, nil
+	}
+
+	for {
+		challenge.Params[strings.ToLower(kv[0])] = kv[1]
+		rem = next
+
+		if next, _, err = httpListSep()(rem); err != nil {
+			break
+		}
+
+		if next, kv, err = httpParam()(next); err != nil {
+			break
+		}
+	}
+
+	return rem, challenge, nil
+}
+
+// LinkParts contains the individual parts of a parsed Link header entry.
+type LinkParts struct {
+	// URI is the target of the link, without its enclosing angle brackets.
+	URI string
+
+	// Params contains any parameters of the link, such as rel. Keys are
+	// normalized to lowercase and values are unquoted.
+	Params map[string]string
+}
+
+// LinkHeader will parse the value of a Link header, as defined by RFC 8288, a
+// comma separated list of one or more links. Each link is a URI enclosed within
+// angle brackets, followed by any number of parameters, <uri>; key=value. Any
+// commas within a URI or a quoted parameter value do not separate links.
+//
+//	chomp.LinkHeader()(`<https://api/items?page=2>; rel="next", <https://api/items?page=9>; rel="last"`)
+//	// ("", []chomp.LinkParts{
+//	//   {URI: "https://api/items?page=2", Params: {"rel": "next"}},
+//	//   {URI: "https://api/items?page=9", Params: {"rel": "last"}}}, nil)
+func LinkHeader() Combinator[[]LinkParts] {
+	return func(s string) (string, []LinkParts, error) {
+		rem, link, err := linkValue(s)
+		if err != nil {
+			return s, nil, ParserError{Err: err, Type: "link_header"}
+		}
+		links := []LinkParts{link}
+
+		for {
+			next, _, err := httpListSep()(rem)
+			if err != nil {
+				break
+			}
+
+			if next, link, err = linkValue(next); err != nil {
+				break
+			}
+			links = append(links, link)
+			rem = next
+		}
+
+		return rem, links, nil
+	}
+}
+
+func linkValue(s string) (string, LinkParts, error) {
+	rem, uri, err := Delimited(Tag("<"), Not(">"), Tag(">"))(s)
+	if err != nil {
+		return s, LinkParts{}, err
+	}
+
+	rem, params, err := httpParams(rem)
+	if err != nil {
+		return s, LinkParts{}, err
+	}
+
+	return rem, LinkParts{URI: uri, Params: params}, nil
+}
+
+// CacheControl will parse the value of a Cache-Control header, a comma separated
+// list of one or more directives. A directive can optionally have a value, which
+// can either be a token or a quoted-string, directive=value. Directive names are
+// normalized to lowercase, with directives that have no value mapping to an empty
+// string.
+//
+//	chomp.CacheControl()(`max-age=3600, no-cache="Set-Cookie", PRIVATE`)
+//	// ("", map[string]string{"max-age": "3600", "no-cache": "Set-Cookie", "private": ""}, nil)
+func CacheControl() Combinator[map[string]string] {
+	return func(s string) (string, map[string]string, error) {
+		rem, directive, err := cacheDirective()(s)
+		if err != nil {
+			return s, nil, ParserError{Err: err, Type: "cache_control"}
+		}
+		directives := map[string]string{strings.ToLower(directive[0]): directive[1]}
+
+		for {
+			next, _, err := httpListSep()(rem)
+			if err != nil {
+				break
+			}
+
+			if next, directive, err = cacheDirective()(next); err != nil {
+				break
+			}
+			directives[strings.ToLower(directive[0])] = directive[1]
+			rem = next
+		}
+
+		return rem, directives, nil
+	}
+}
+
+func cacheDirective() Combinator[[]string] {
+	return func(s string) (string, []string, error) {
+		if rem, kv, err := httpParam()(s); err == nil {
+			return rem, kv, nil
+		}
+
+		rem, name, err := While(isHTTPToken{})(s)
+		if err != nil {
+			return s, nil, err
+		}
+
+		if _, _, err := Delimited(WhileN(isBlank{}, 0), Tag("="), WhileN(isBlank{}, 0))(rem); err == nil {
+			return s, nil, CombinatorParseError{Text: s, Type: "cache_directive"}
+		}
+
+		return rem, []string{name, ""}, nil
+	}
+}
+
+type isETagChar struct{}
+
+func (isETagChar) Match(r rune) bool {
+	return r == 0x21 || (r >= 0x23 && r <= 0x7e) || r >= 0x80
+}
+
+func (isETagChar) String() string {
+	return "is_etag_char"
+}
+
+// ETagParts contains the individual parts of a parsed entity tag.
+type ETagParts struct {
+	// Weak identifies if the entity tag is a weak validator, W/"value".
+	Weak bool
+
+	// Value is the opaque value of the entity tag, without its quotes.
+	Value string
+}
+
+// ETag will parse an entity tag, as used within the ETag header. An entity tag
+// is an opaque value enclosed within double quotes, "value", and is optionally
+// prefixed by a case-sensitive W/ to identify it as a weak validator. Quotes
+// cannot be escaped within the opaque value.
+//
+//	chomp.ETag()(`W/"67ab43"`)
+//	// ("", chomp.ETagParts{Weak: true, Value: "67ab43"}, nil)
+func ETag() Combinator[ETagParts] {
+	return func(s string) (string, ETagParts, error) {
+		rem, weak, _ := Opt(Tag("W/"))(s)
+
+		rem, value, err := Delimited(Tag(`"`), WhileN(isETagChar{}, 0), Tag(`"`))(rem)
+		if err != nil {
+			return s, ETagParts{}, ParserError{Err: err, Type: "etag"}
+		}
+
+		return rem, ETagParts{Weak: weak != "", Value: value}, nil
+	}
+}
+
+// IfMatchParts contains the individual parts of a parsed If-Match or
+// If-None-Match header.
+type IfMatchParts struct {
+	// Wildcard identifies if the header matches any entity tag, *.
+	Wildcard bool
+
+	// ETags contains each entity tag within the list.
+	ETags []ETagParts
+}
+
+// IfMatchList will parse the value of an If-Match or If-None-Match header, either
+// a comma separated list of one or more entity tags or a wildcard, *.
+//
+//	chomp.IfMatchList()(`"xyzzy", W/"r2d2xxxx"`)
+//	// ("", chomp.IfMatchParts{ETags: []chomp.ETagParts{
+//	//   {Value: "xyzzy"}, {Weak: true, Value: "r2d2xxxx"}}}, nil)
+func IfMatchList() Combinator[IfMatchParts] {
+	return func(s string) (string, IfMatchParts, error) {
+		if rem, _, err := Tag("*")(s); err == nil {
+			return rem, IfMatchParts{Wildcard: true}, nil
+		}
+
+		rem, etag, err := ETag()(s)
+		if err != nil {
+			return s, IfMatchParts{}, ParserError{Err: err, Type: "if_match_list"}
+		}
+		etags := []ETagParts{etag}
+
+		for {
+			next, _, err := httpListSep()(rem)
+			if err != nil {
+				break
+			}
+
+			if next, etag, err = ETag()(next); err != nil {
+				break
+			}
+			etags = append(etags, etag)
+			rem = next
+		}
+
+		return rem, IfMatchParts{ETags: etags}, nil
+	}
+}
+
+// TraceParentParts contains the individual parts of a parsed W3C trace
+// context traceparent header.
+type TraceParentParts struct {
+	// Version of the trace context format, as two hex digits.
+	Version string
+
+	// TraceID uniquely identifies the distributed trace, as 32 hex digits.
+	TraceID string
+
+	// ParentID identifies the span of the caller, as 16 hex digits.
+	ParentID string
+
+	// Flags contains the trace flags, such as sampled, as two hex digits.
+	Flags string
+}
+
+// TraceParent will parse the value of a W3C trace context traceparent header,
+// version-traceid-parentid-flags. Each field must contain the exact number of
+// lowercase hex digits. As defined by the specification, the version cannot be
+// ff and both the trace and parent IDs cannot be all zeros.
+//
+//	chomp.TraceParent()("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+//	// ("", chomp.TraceParentParts{
+//	//   Version: "00", TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", ParentID: "00f067aa0ba902b7", Flags: "01"}, nil)
+func TraceParent() Combinator[TraceParentParts] {
+	return func(s string) (string, TraceParentParts, error) {
+		rem, ext, err := All(
+			traceField(2),
+			Tag("-"),
+			traceField(32),
+			Tag("-"),
+			traceField(16),
+			Tag("-"),
+			traceField(2))(s)
+		if err != nil {
+			return s, TraceParentParts{}, ParserError{Err: err, Type: "traceparent"}
+		}
+
+		tp := TraceParentParts{Version: ext[0], TraceID: ext[2], ParentID: ext[4], Flags: ext[6]}
+
+		var invalid error
+		switch {
+		case tp.Version == "ff":
+			invalid = CombinatorParseError{Input: tp.Version, Text: s, Type: "traceparent_version"}
+		case strings.Trim(tp.TraceID, "0") == "":
+			invalid = CombinatorParseError{Input: tp.TraceID, Text: s, Type: "traceparent_trace_id"}
+		case strings.Trim(tp.ParentID, "0") == "":
+			invalid = CombinatorParseError{Input: tp.ParentID, Text: s, Type: "traceparent_parent_id"}
+		}
+
+		if invalid != nil {
+			return s, TraceParentParts{}, ParserError{Err: invalid, Type: "traceparent"}
+		}
+
+		return rem, tp, nil
+	}
+}
+
+func traceField(n uint) Combinator[string] {
+	return TakeWhileMN(n, n, func(r rune) bool {
+		return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'f')
+	})
+}
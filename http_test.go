@@ -0,0 +1,672 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentDisposition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.ContentDispositionParts
+	}{
+		{
+			name:     "TypeOnly",
+			input:    "INLINE",
+			expected: chomp.ContentDispositionParts{Type: "inline", Params: map[string]string{}},
+		},
+		{
+			name:  "Token",
+			input: "attachment; filename=report.pdf",
+			expected: chomp.ContentDispositionParts{
+				Type:   "attachment",
+				Params: map[string]string{"filename": "report.pdf"},
+			},
+		},
+		{
+			name:  "QuotedString",
+			input: `form-data;name="upload";  FILENAME="my \"best\" file.txt"`,
+			expected: chomp.ContentDispositionParts{
+				Type:   "form-data",
+				Params: map[string]string{"name": "upload", "filename": `my "best" file.txt`},
+			},
+		},
+		{
+			name:  "ExtendedTakesPrecedence",
+			input: `attachment; filename*=UTF-8''na%C3%AFve%20file.txt; filename="naive file.txt"`,
+			expected: chomp.ContentDispositionParts{
+				Type:   "attachment",
+				Params: map[string]string{"filename": "naïve file.txt"},
+			},
+		},
+		{
+			name:  "ExtendedLatin1",
+			input: `attachment; filename*=iso-8859-1'en'%A3%20rates.txt`,
+			expected: chomp.ContentDispositionParts{
+				Type:   "attachment",
+				Params: map[string]string{"filename": "£ rates.txt"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, cd, err := chomp.ContentDisposition()(tt.input + "\r\n")
+
+			require.NoError(t, err)
+			assert.Equal(t, "\r\n", rem)
+			assert.Equal(t, tt.expected, cd)
+		})
+	}
+}
+
+func TestContentDispositionInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "MissingType",
+			input: "; filename=report.pdf",
+		},
+		{
+			name:  "MissingValue",
+			input: "attachment; filename=",
+		},
+		{
+			name:  "UnterminatedQuote",
+			input: `attachment; filename="report.pdf`,
+		},
+		{
+			name:  "UnsupportedCharset",
+			input: `attachment; filename*=UTF-16''report.pdf`,
+		},
+		{
+			name:  "MalformedExtendedValue",
+			input: `attachment; filename*=report.pdf`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.ContentDisposition()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestExtValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.ExtValueParts
+	}{
+		{
+			name:     "UTF8",
+			input:    "UTF-8'en'%E2%82%AC%20rates",
+			expected: chomp.ExtValueParts{Charset: "UTF-8", Lang: "en", Value: "€ rates"},
+		},
+		{
+			name:     "NoLanguage",
+			input:    "utf-8''na%C3%AFve.txt",
+			expected: chomp.ExtValueParts{Charset: "UTF-8", Value: "naïve.txt"},
+		},
+		{
+			name:     "Latin1",
+			input:    "ISO-8859-1'en-GB'%A3%20rates",
+			expected: chomp.ExtValueParts{Charset: "ISO-8859-1", Lang: "en-GB", Value: "£ rates"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.ExtValue()(tt.input + "; size=10")
+
+			require.NoError(t, err)
+			assert.Equal(t, "; size=10", rem)
+			assert.Equal(t, tt.expected, ext)
+		})
+	}
+}
+
+func TestExtValueInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "MissingLanguageDelimiter",
+			input: "UTF-8%E2%82%AC",
+			err:   "(ext_value) parser failed",
+		},
+		{
+			name:  "InvalidPercentEncoding",
+			input: "UTF-8''%ZZ",
+			err:   "(ext_value) parser failed",
+		},
+		{
+			name:  "UnsupportedCharset",
+			input: "UTF-16''report.pdf",
+			err:   "(ext_value_charset) combinator failed to parse text 'UTF-16''report.pdf' with input 'UTF-16'",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.ExtValue()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestAuthChallenge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []chomp.AuthChallengeParts
+	}{
+		{
+			name:  "SchemeOnly",
+			input: "Negotiate",
+			expected: []chomp.AuthChallengeParts{
+				{Scheme: "negotiate", Params: map[string]string{}},
+			},
+		},
+		{
+			name:  "Params",
+			input: `Bearer realm="example", error="invalid_token", error_description="The access token expired"`,
+			expected: []chomp.AuthChallengeParts{
+				{
+					Scheme: "bearer",
+					Params: map[string]string{
+						"realm":             "example",
+						"error":             "invalid_token",
+						"error_description": "The access token expired",
+					},
+				},
+			},
+		},
+		{
+			name:  "Token68",
+			input: "Negotiate YWxhZGRpbjpvcGVu==",
+			expected: []chomp.AuthChallengeParts{
+				{Scheme: "negotiate", Params: map[string]string{}, Token68: "YWxhZGRpbjpvcGVu=="},
+			},
+		},
+		{
+			name:  "MultipleChallenges",
+			input: `Digest realm="files", qop="auth,auth-int", nonce=abc123 ,Basic realm="simple, right?",Negotiate`,
+			expected: []chomp.AuthChallengeParts{
+				{
+					Scheme: "digest",
+					Params: map[string]string{"realm": "files", "qop": "auth,auth-int", "nonce": "abc123"},
+				},
+				{
+					Scheme: "basic",
+					Params: map[string]string{"realm": "simple, right?"},
+				},
+				{Scheme: "negotiate", Params: map[string]string{}},
+			},
+		},
+		{
+			name:  "Token68FollowedByChallenge",
+			input: "Negotiate abc=, Bearer Realm = api",
+			expected: []chomp.AuthChallengeParts{
+				{Scheme: "negotiate", Params: map[string]string{}, Token68: "abc="},
+				{Scheme: "bearer", Params: map[string]string{"realm": "api"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, challenges, err := chomp.AuthChallenge()(tt.input + "\r\n")
+
+			require.NoError(t, err)
+			assert.Equal(t, "\r\n", rem)
+			assert.Equal(t, tt.expected, challenges)
+		})
+	}
+}
+
+func TestAuthChallengeTrailingComma(t *testing.T) {
+	t.Parallel()
+
+	rem, challenges, err := chomp.AuthChallenge()(`Basic realm="web", `)
+
+	require.NoError(t, err)
+	assert.Equal(t, ", ", rem)
+	require.Len(t, challenges, 1)
+	assert.Equal(t, map[string]string{"realm": "web"}, challenges[0].Params)
+}
+
+func TestAuthChallengeInvalid(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.AuthChallenge()(`, Basic realm="web"`)
+
+	require.Error(t, err)
+	assert.Equal(t, `, Basic realm="web"`, rem)
+	assert.ErrorContains(t, err, "(auth_challenge) parser failed")
+}
+
+func TestLinkHeader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []chomp.LinkParts
+	}{
+		{
+			name:  "NoParams",
+			input: "<https://example.com/style.css>",
+			expected: []chomp.LinkParts{
+				{URI: "https://example.com/style.css", Params: map[string]string{}},
+			},
+		},
+		{
+			name:  "Pagination",
+			input: `<https://api.github.com/repos?page=2>; rel="next",<https://api.github.com/repos?page=5>; REL=last`,
+			expected: []chomp.LinkParts{
+				{URI: "https://api.github.com/repos?page=2", Params: map[string]string{"rel": "next"}},
+				{URI: "https://api.github.com/repos?page=5", Params: map[string]string{"rel": "last"}},
+			},
+		},
+		{
+			name:  "CommasWithinLink",
+			input: `</search?q=a,b>; rel="alternate"; title="Results, page 1" , </terms>; rel=license`,
+			expected: []chomp.LinkParts{
+				{URI: "/search?q=a,b", Params: map[string]string{"rel": "alternate", "title": "Results, page 1"}},
+				{URI: "/terms", Params: map[string]string{"rel": "license"}},
+			},
+		},
+		{
+			name:  "ExtendedParam",
+			input: `</chapter2>; rel="next"; title*=UTF-8'de'n%c3%a4chstes%20Kapitel`,
+			expected: []chomp.LinkParts{
+				{URI: "/chapter2", Params: map[string]string{"rel": "next", "title": "nächstes Kapitel"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, links, err := chomp.LinkHeader()(tt.input + "\r\n")
+
+			require.NoError(t, err)
+			assert.Equal(t, "\r\n", rem)
+			assert.Equal(t, tt.expected, links)
+		})
+	}
+}
+
+func TestLinkHeaderInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "MissingBrackets",
+			input: `https://api/next; rel="next"`,
+		},
+		{
+			name:  "UnterminatedURI",
+			input: `<https://api/next; rel="next"`,
+		},
+		{
+			name:  "MalformedParam",
+			input: `<https://api/next>; rel="next`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.LinkHeader()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(link_header) parser failed")
+		})
+	}
+}
+
+func TestCacheControl(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]string
+	}{
+		{
+			name:     "Single",
+			input:    "no-store",
+			expected: map[string]string{"no-store": ""},
+		},
+		{
+			name:  "Mixed",
+			input: `max-age=3600, NO-CACHE,private ,  s-maxage = 60`,
+			expected: map[string]string{
+				"max-age":  "3600",
+				"no-cache": "",
+				"private":  "",
+				"s-maxage": "60",
+			},
+		},
+		{
+			name:  "QuotedValue",
+			input: `no-cache="Set-Cookie, Authorization", must-revalidate`,
+			expected: map[string]string{
+				"no-cache":        "Set-Cookie, Authorization",
+				"must-revalidate": "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, directives, err := chomp.CacheControl()(tt.input + "\r\n")
+
+			require.NoError(t, err)
+			assert.Equal(t, "\r\n", rem)
+			assert.Equal(t, tt.expected, directives)
+		})
+	}
+}
+
+func TestCacheControlTrailingInvalidDirective(t *testing.T) {
+	t.Parallel()
+
+	rem, directives, err := chomp.CacheControl()(`public, max-age=`)
+
+	require.NoError(t, err)
+	assert.Equal(t, ", max-age=", rem)
+	assert.Equal(t, map[string]string{"public": ""}, directives)
+}
+
+func TestCacheControlInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+		},
+		{
+			name:  "MissingValue",
+			input: "max-age=, public",
+		},
+		{
+			name:  "UnterminatedQuote",
+			input: `no-cache="Set-Cookie`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.CacheControl()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(cache_control) parser failed")
+		})
+	}
+}
+
+func TestETag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.ETagParts
+	}{
+		{
+			name:     "Strong",
+			input:    `"33a64df551425fcc55e4d42a148795d9f25f89d4"`,
+			expected: chomp.ETagParts{Value: "33a64df551425fcc55e4d42a148795d9f25f89d4"},
+		},
+		{
+			name:     "Weak",
+			input:    `W/"0815"`,
+			expected: chomp.ETagParts{Weak: true, Value: "0815"},
+		},
+		{
+			name:     "Empty",
+			input:    `""`,
+			expected: chomp.ETagParts{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, etag, err := chomp.ETag()(tt.input + "\r\n")
+
+			require.NoError(t, err)
+			assert.Equal(t, "\r\n", rem)
+			assert.Equal(t, tt.expected, etag)
+		})
+	}
+}
+
+func TestETagInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Unquoted",
+			input: "0815",
+		},
+		{
+			name:  "LowercaseWeak",
+			input: `w/"0815"`,
+		},
+		{
+			name:  "Unterminated",
+			input: `W/"0815`,
+		},
+		{
+			name:  "ContainsSpace",
+			input: `"08 15"`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.ETag()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(etag) parser failed")
+		})
+	}
+}
+
+func TestIfMatchList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.IfMatchParts
+	}{
+		{
+			name:     "Wildcard",
+			input:    "*",
+			expected: chomp.IfMatchParts{Wildcard: true},
+		},
+		{
+			name:  "Single",
+			input: `"xyzzy"`,
+			expected: chomp.IfMatchParts{
+				ETags: []chomp.ETagParts{{Value: "xyzzy"}},
+			},
+		},
+		{
+			name:  "Multiple",
+			input: `"xyzzy", W/"r2d2xxxx" ,"c3piozzzz"`,
+			expected: chomp.IfMatchParts{
+				ETags: []chomp.ETagParts{
+					{Value: "xyzzy"},
+					{Weak: true, Value: "r2d2xxxx"},
+					{Value: "c3piozzzz"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, list, err := chomp.IfMatchList()(tt.input + "\r\n")
+
+			require.NoError(t, err)
+			assert.Equal(t, "\r\n", rem)
+			assert.Equal(t, tt.expected, list)
+		})
+	}
+}
+
+func TestIfMatchListInvalid(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.IfMatchList()(`xyzzy, "r2d2"`)
+
+	require.Error(t, err)
+	assert.Equal(t, `xyzzy, "r2d2"`, rem)
+	assert.ErrorContains(t, err, "(if_match_list) parser failed")
+}
+
+func TestTraceParent(t *testing.T) {
+	t.Parallel()
+
+	rem, tp, err := chomp.TraceParent()("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01\r\n")
+
+	require.NoError(t, err)
+	assert.Equal(t, "\r\n", rem)
+	assert.Equal(t, chomp.TraceParentParts{
+		Version:  "00",
+		TraceID:  "4bf92f3577b34da6a3ce929d0e0e4736",
+		ParentID: "00f067aa0ba902b7",
+		Flags:    "01",
+	}, tp)
+}
+
+func TestTraceParentInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "ShortTraceID",
+			input: "00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+			err:   "(take_while_m_n) parser failed [count: 31 min: 32 max: 32]",
+		},
+		{
+			name:  "LongParentID",
+			input: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b70-01",
+			err:   "with input '-'",
+		},
+		{
+			name:  "UppercaseHex",
+			input: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+			err:   "(take_while_m_n) parser failed [count: 1 min: 32 max: 32]",
+		},
+		{
+			name:  "InvalidVersion",
+			input: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			err:   "(traceparent_version) combinator failed",
+		},
+		{
+			name:  "ZeroTraceID",
+			input: "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			err:   "(traceparent_trace_id) combinator failed",
+		},
+		{
+			name:  "ZeroParentID",
+			input: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+			err:   "(traceparent_parent_id) combinator failed",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.TraceParent()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(traceparent) parser failed")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package chomp
+
+// IndentOption configures how [Indent] measures indentation.
+type IndentOption func(*indentOptions)
+
+type indentOptions struct {
+	tabWidth   int
+	allowMixed bool
+}
+
+// TabWidth sets the width of a tab when measuring indentation. A tab
+// advances the indentation to the next multiple of the width. Defaults to 8.
+// A width less than 1 is ignored.
+func TabWidth(n int) IndentOption {
+	return func(opts *indentOptions) {
+		if n > 0 {
+			opts.tabWidth = n
+		}
+	}
+}
+
+// AllowMixedIndent permits [Indent] to measure indentation containing both
+// tabs and spaces. By default, mixing them is rejected, as the resulting
+// width is ambiguous.
+func AllowMixedIndent() IndentOption {
+	return func(opts *indentOptions) {
+		opts.allowMixed = true
+	}
+}
+
+// Indent will measure the indentation at the start of the input text,
+// returning its width. The indentation, consisting of spaces and tabs, is
+// consumed, leaving the rest of the line untouched. No indentation results
+// in a width of zero. Indentation containing both tabs and spaces is rejected,
+// unless [AllowMixedIndent] is provided. It is a building block for parsing
+// formats that follow the offside rule, such as YAML.
+//
+//	chomp.Indent()("    key: value")
+//	// ("key: value", 4, nil)
+//
+//	chomp.Indent(chomp.TabWidth(4))("\t\tkey: value")
+//	// ("key: value", 8, nil)
+func Indent(opts ...IndentOption) Combinator[int] {
+	cfg := indentOptions{tabWidth: 8}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(s string) (string, int, error) {
+		var width, i int
+		var tabs, spaces bool
+
+	loop:
+		for ; i < len(s); i++ {
+			switch s[i] {
+			case ' ':
+				spaces = true
+				width++
+			case '\t':
+				tabs = true
+				width += cfg.tabWidth - width%cfg.tabWidth
+			default:
+				break loop
+			}
+		}
+
+		if tabs && spaces && !cfg.allowMixed {
+			return s, 0, CombinatorParseError{Text: s, Type: "indent_mixed"}
+		}
+
+		return s[i:], width, nil
+	}
+}
@@ -0,0 +1,83 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		opts  []chomp.IndentOption
+		rem   string
+		width int
+	}{
+		{
+			name:  "None",
+			input: "key: value",
+			rem:   "key: value",
+			width: 0,
+		},
+		{
+			name:  "Spaces",
+			input: "    key: value\n  next",
+			rem:   "key: value\n  next",
+			width: 4,
+		},
+		{
+			name:  "Tabs",
+			input: "\t\tkey",
+			rem:   "key",
+			width: 16,
+		},
+		{
+			name:  "TabWidth",
+			input: "\t\tkey",
+			opts:  []chomp.IndentOption{chomp.TabWidth(4)},
+			rem:   "key",
+			width: 8,
+		},
+		{
+			name:  "BlankLine",
+			input: "   \nkey",
+			rem:   "\nkey",
+			width: 3,
+		},
+		{
+			name:  "MixedTabStop",
+			input: "  \t key",
+			opts:  []chomp.IndentOption{chomp.TabWidth(4), chomp.AllowMixedIndent()},
+			rem:   "key",
+			width: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, width, err := chomp.Indent(tt.opts...)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.width, width)
+		})
+	}
+}
+
+func TestIndentMixed(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.Indent()("\t  key")
+
+	require.Error(t, err)
+	assert.Equal(t, "\t  key", rem)
+	assert.EqualError(t, err, "(indent_mixed) combinator failed to parse text '\t  key'")
+}
@@ -0,0 +1,162 @@
+package chomp
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// JSONString will parse a double-quoted JSON string, as defined by RFC 8259,
+// returning its decoded value. All standard escape sequences are decoded,
+// including \uXXXX, where a UTF-16 surrogate pair, such as \ud83d\ude00, is
+// combined into a single rune. A lone surrogate is rejected, as are any raw
+// control characters (below U+0020).
+//
+//	chomp.JSONString()(`"caf\u00e9 \ud83d\ude00",`)
+//	// (",", "café 😀", nil)
+func JSONString() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, _, err := Tag(`"`)(s)
+		if err != nil {
+			return s, "", ParserError{Err: err, Type: "json_string"}
+		}
+
+		var buf strings.Builder
+		for {
+			r, size := utf8.DecodeRuneInString(rem)
+			switch {
+			case size == 0:
+				return s, "", CombinatorParseError{Input: `"`, Text: rem, Type: "json_string"}
+			case r == '"':
+				return rem[size:], buf.String(), nil
+			case r < 0x20:
+				return s, "", ParserError{
+					Err:  CombinatorParseError{Text: rem, Type: "json_control_char"},
+					Type: "json_string",
+				}
+			case r == '\\':
+				var esc rune
+				if rem, esc, err = jsonEscape(rem); err != nil {
+					return s, "", ParserError{Err: err, Type: "json_string"}
+				}
+				buf.WriteRune(esc)
+			default:
+				buf.WriteString(rem[:size])
+				rem = rem[size:]
+			}
+		}
+	}
+}
+
+var jsonEscapes = map[byte]rune{
+	'"':  '"',
+	'\\': '\\',
+	'/':  '/',
+	'b':  '\b',
+	'f':  '\f',
+	'n':  '\n',
+	'r':  '\r',
+	't':  '\t',
+}
+
+// jsonEscape decodes a single escape sequence, including its leading
+// backslash. A high surrogate must be immediately followed by an escaped
+// low surrogate
+func jsonEscape(s string) (string, rune, error) {
+	if len(s) < 2 {
+		return s, 0, CombinatorParseError{Text: s, Type: "json_escape"}
+	}
+
+	if r, ok := jsonEscapes[s[1]]; ok {
+		return s[2:], r, nil
+	}
+
+	rem, r, err := jsonUnicodeEscape(s)
+	if err != nil {
+		return s, 0, err
+	}
+
+	if !utf16.IsSurrogate(r) {
+		return rem, r, nil
+	}
+
+	if r < 0xdc00 {
+		if next, low, err := jsonUnicodeEscape(rem); err == nil {
+			if dec := utf16.DecodeRune(r, low); dec != utf8.RuneError {
+				return next, dec, nil
+			}
+		}
+	}
+
+	return s, 0, CombinatorParseError{Text: s, Type: "json_surrogate"}
+}
+
+func jsonUnicodeEscape(s string) (string, rune, error) {
+	rem, hex, err := Preceded(Tag(`\u`), TakeWhileMN(4, 4, isHexDigit{}.Match))(s)
+	if err != nil {
+		return s, 0, CombinatorParseError{Text: s, Type: "json_escape"}
+	}
+
+	n, _ := strconv.ParseUint(hex, 16, 16)
+	return rem, rune(n), nil
+}
+
+// JSONNumber will parse a JSON number, as defined by RFC 8259, returning
+// its value as a float64. The number must strictly follow the grammar:
+//
+//	number   = ["-"] integer [fraction] [exponent]
+//	integer  = "0" / (DIGIT1-9 *DIGIT)
+//	fraction = "." 1*DIGIT
+//	exponent = ("e" / "E") ["+" / "-"] 1*DIGIT
+//
+// Unlike a general purpose float, a leading '+', leading zeros (01), a missing
+// integer part (.5) and a dangling decimal point (1.) are all rejected. A
+// number too large to be represented as a float64 is also rejected.
+//
+//	chomp.JSONNumber()("-12.5e3,")
+//	// (",", -12500, nil)
+func JSONNumber() Combinator[float64] {
+	return func(s string) (string, float64, error) {
+		jsonErr := func(text, typ string) (string, float64, error) {
+			return s, 0, ParserError{
+				Err:  CombinatorParseError{Text: text, Type: typ},
+				Type: "json_number",
+			}
+		}
+
+		rem, _, _ := Opt(Tag("-"))(s)
+
+		switch {
+		case strings.HasPrefix(rem, "0"):
+			rem = rem[1:]
+			if _, _, err := While(isASCIIDigit{})(rem); err == nil {
+				return jsonErr(rem, "json_number_integer")
+			}
+		case rem != "" && rem[0] >= '1' && rem[0] <= '9':
+			rem, _, _ = While(isASCIIDigit{})(rem)
+		default:
+			return jsonErr(rem, "json_number_integer")
+		}
+
+		if next, _, err := Tag(".")(rem); err == nil {
+			if rem, _, err = While(isASCIIDigit{})(next); err != nil {
+				return jsonErr(next, "json_number_fraction")
+			}
+		}
+
+		if next, _, err := OneOf("eE")(rem); err == nil {
+			next, _, _ = Opt(OneOf("+-"))(next)
+			if rem, _, err = While(isASCIIDigit{})(next); err != nil {
+				return jsonErr(next, "json_number_exponent")
+			}
+		}
+
+		n, err := strconv.ParseFloat(s[:len(s)-len(rem)], 64)
+		if err != nil {
+			return s, 0, ParserError{Err: err, Type: "json_number"}
+		}
+
+		return rem, n, nil
+	}
+}
@@ -0,0 +1,234 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Empty",
+			input: `"",`,
+			rem:   ",",
+			ext:   "",
+		},
+		{
+			name:  "Unicode",
+			input: `"こんにちは, World!"}`,
+			rem:   "}",
+			ext:   "こんにちは, World!",
+		},
+		{
+			name:  "Escapes",
+			input: `"\"\\\/\b\f\n\r\t"`,
+			rem:   "",
+			ext:   "\"\\/\b\f\n\r\t",
+		},
+		{
+			name:  "UnicodeEscape",
+			input: `"caf\u00e9 \u2603"`,
+			rem:   "",
+			ext:   "café ☃",
+		},
+		{
+			name:  "SurrogatePair",
+			input: `"\ud83d\uDE00 grinning"`,
+			rem:   "",
+			ext:   "😀 grinning",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.JSONString()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestJSONStringInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "NotQuoted",
+			input: "hello",
+			err:   "(json_string) parser failed. (tag) combinator failed to parse text 'hello' with input '\"'",
+		},
+		{
+			name:  "Unterminated",
+			input: `"hello`,
+			err:   "(json_string) combinator failed to parse text '' with input '\"'",
+		},
+		{
+			name:  "ControlCharacter",
+			input: "\"hello\tworld\"",
+			err:   "(json_control_char) combinator failed to parse text '\tworld\"'",
+		},
+		{
+			name:  "UnknownEscape",
+			input: `"\x41"`,
+			err:   `(json_escape) combinator failed to parse text '\x41"'`,
+		},
+		{
+			name:  "ShortUnicodeEscape",
+			input: `"\u12"`,
+			err:   `(json_escape) combinator failed to parse text '\u12"'`,
+		},
+		{
+			name:  "LoneHighSurrogate",
+			input: `"\ud83d!"`,
+			err:   `(json_surrogate) combinator failed to parse text '\ud83d!"'`,
+		},
+		{
+			name:  "LoneLowSurrogate",
+			input: `"\ude00"`,
+			err:   `(json_surrogate) combinator failed to parse text '\ude00"'`,
+		},
+		{
+			name:  "ReversedSurrogatePair",
+			input: `"\ude00\ud83d"`,
+			err:   `(json_surrogate) combinator failed to parse text '\ude00\ud83d"'`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.JSONString()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestJSONNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		num   float64
+	}{
+		{name: "Zero", input: "0", rem: "", num: 0},
+		{name: "NegativeZero", input: "-0,", rem: ",", num: 0},
+		{name: "Integer", input: "1234]", rem: "]", num: 1234},
+		{name: "Negative", input: "-42", rem: "", num: -42},
+		{name: "Fraction", input: "0.25}", rem: "}", num: 0.25},
+		{name: "Exponent", input: "1e3", rem: "", num: 1000},
+		{name: "UppercaseExponent", input: "2E2", rem: "", num: 200},
+		{name: "SignedExponent", input: "-12.5e+3 ", rem: " ", num: -12500},
+		{name: "NegativeExponent", input: "5E-2", rem: "", num: 0.05},
+		{name: "ZeroWithExponent", input: "0e10", rem: "", num: 0},
+		{name: "StopsAtNonDigit", input: "0x1F", rem: "x1F", num: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, num, err := chomp.JSONNumber()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.num, num)
+		})
+	}
+}
+
+func TestJSONNumberInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "LeadingZero",
+			input: "01",
+			err:   "(json_number_integer) combinator failed to parse text '1'",
+		},
+		{
+			name:  "MissingInteger",
+			input: ".5",
+			err:   "(json_number_integer) combinator failed to parse text '.5'",
+		},
+		{
+			name:  "LeadingPlus",
+			input: "+1",
+			err:   "(json_number_integer) combinator failed to parse text '+1'",
+		},
+		{
+			name:  "MinusOnly",
+			input: "-",
+			err:   "(json_number_integer) combinator failed to parse text ''",
+		},
+		{
+			name:  "MinusBeforeFraction",
+			input: "-.5",
+			err:   "(json_number_integer) combinator failed to parse text '.5'",
+		},
+		{
+			name:  "DanglingDecimalPoint",
+			input: "1.",
+			err:   "(json_number_fraction) combinator failed to parse text ''",
+		},
+		{
+			name:  "DanglingExponent",
+			input: "1e",
+			err:   "(json_number_exponent) combinator failed to parse text ''",
+		},
+		{
+			name:  "SignedDanglingExponent",
+			input: "1.5e-x",
+			err:   "(json_number_exponent) combinator failed to parse text 'x'",
+		},
+		{
+			name:  "OutOfRange",
+			input: "1e400",
+			err:   "value out of range",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.JSONNumber()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(json_number) parser failed")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package chomp
+
+import (
+	"strings"
+	"unicode"
+)
+
+// KeyBindingParts contains the individual parts of a parsed keyboard shortcut.
+type KeyBindingParts struct {
+	// Mods contains the normalized modifier keys in the order they were
+	// declared. Possible values are: ctrl, shift, alt and meta.
+	Mods []string
+
+	// Key is the final non-modifier key within the shortcut.
+	Key string
+}
+
+var keyModifiers = map[string]string{
+	"ctrl":    "ctrl",
+	"ctl":     "ctrl",
+	"control": "ctrl",
+	"shift":   "shift",
+	"alt":     "alt",
+	"opt":     "alt",
+	"option":  "alt",
+	"meta":    "meta",
+	"cmd":     "meta",
+	"command": "meta",
+	"super":   "meta",
+	"win":     "meta",
+}
+
+type isKeyName struct{}
+
+func (isKeyName) Match(r rune) bool {
+	return r != '+' && r != '-' && !unicode.IsSpace(r)
+}
+
+func (isKeyName) String() string {
+	return "is_key_name"
+}
+
+// KeyBinding will parse a keyboard shortcut made up of any number of
+// modifier keys followed by a single key. Modifiers can be separated
+// by either a '+' or '-' and are case-insensitive. Common aliases are
+// normalized, for example Control and Ctl become ctrl, Option becomes
+// alt and Cmd, Super and Win become meta.
+//
+//	chomp.KeyBinding()("Ctrl+Shift+K")
+//	// ("", chomp.KeyBindingParts{Mods: []string{"ctrl", "shift"}, Key: "K"}, nil)
+func KeyBinding() Combinator[KeyBindingParts] {
+	return Combinator[KeyBindingParts](Map(
+		Pair(ManyN(Suffixed(keyModifier(), OneOf("+-")), 0), First(While(isKeyName{}), OneOf("+-"))),
+		func(in []string) KeyBindingParts {
+			return KeyBindingParts{Mods: in[:len(in)-1], Key: in[len(in)-1]}
+		},
+	))
+}
+
+func keyModifier() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, ext, err := While(IsLetter)(s)
+		if err != nil {
+			return rem, "", err
+		}
+
+		if mod, ok := keyModifiers[strings.ToLower(ext)]; ok {
+			return rem, mod, nil
+		}
+
+		return s, "", CombinatorParseError{Text: s, Type: "key_modifier"}
+	}
+}
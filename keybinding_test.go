@@ -0,0 +1,83 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyBinding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		mods  []string
+		key   string
+	}{
+		{
+			name:  "PlusSeparator",
+			input: "Ctrl+Shift+K",
+			rem:   "",
+			mods:  []string{"ctrl", "shift"},
+			key:   "K",
+		},
+		{
+			name:  "DashSeparator",
+			input: "Alt-Tab to switch",
+			rem:   " to switch",
+			mods:  []string{"alt"},
+			key:   "Tab",
+		},
+		{
+			name:  "NormalizedAliases",
+			input: "Control+Option+Cmd+F5",
+			rem:   "",
+			mods:  []string{"ctrl", "alt", "meta"},
+			key:   "F5",
+		},
+		{
+			name:  "SeparatorAsKey",
+			input: "ctrl++",
+			rem:   "",
+			mods:  []string{"ctrl"},
+			key:   "+",
+		},
+		{
+			name:  "ModifierAsKey",
+			input: "Ctrl+Shift",
+			rem:   "",
+			mods:  []string{"ctrl"},
+			key:   "Shift",
+		},
+		{
+			name:  "NoModifiers",
+			input: "Esc",
+			rem:   "",
+			mods:  []string{},
+			key:   "Esc",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.KeyBinding()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.mods, ext.Mods)
+			assert.Equal(t, tt.key, ext.Key)
+		})
+	}
+}
+
+func TestKeyBindingNoKey(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.KeyBinding()(" Ctrl")
+	require.Error(t, err)
+}
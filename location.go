@@ -0,0 +1,131 @@
+package chomp
+
+import (
+	"fmt"
+	"sort"
+	"unicode/utf8"
+)
+
+// Position identifies a single location within some text.
+type Position struct {
+	// Offset is the zero-based byte offset into the text.
+	Offset int
+
+	// Line is the one-based line number.
+	Line int
+
+	// Column is the one-based column number, measured in runes.
+	Column int
+}
+
+// String returns a string representation of a [Position] in the
+// format line:column.
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Span identifies a region of text that has been matched by a [Combinator].
+// The start of the region is inclusive and the end exclusive.
+type Span struct {
+	// Start of the matched region.
+	Start Position
+
+	// End of the matched region.
+	End Position
+}
+
+// String returns a string representation of a [Span] in the format
+// line:column-line:column.
+func (s Span) String() string {
+	return fmt.Sprintf("%s-%s", s.Start, s.End)
+}
+
+// Located will scan the input text and apply the [Combinator], returning
+// the [Span] of the region it matched. The [Span] is relative to the original
+// input text tracked by the [Source], allowing it to be used within an AST or
+// to report diagnostics. As combinators only ever consume from the front of
+// the text, the input text must be a suffix of the original.
+//
+//	src := chomp.NewSource("Hello,\nWorld!")
+//	rem, _, _ := chomp.Tag("Hello,\n")("Hello,\nWorld!")
+//	chomp.Located(src, chomp.Tag("World"))(rem)
+//	// ("!", chomp.Span{Start: {7, 2, 1}, End: {12, 2, 6}}, "World", nil)
+func Located[T any](src *Source, c Combinator[T]) func(string) (string, Span, T, error) {
+	return func(s string) (string, Span, T, error) {
+		rem, ext, err := c(s)
+		if err != nil {
+			var out T
+			return rem, Span{}, out, err
+		}
+
+		return rem, Span{
+			Start: src.Position(src.Offset(s)),
+			End:   src.Position(src.Offset(rem)),
+		}, ext, nil
+	}
+}
+
+// Spanned will scan the input text and apply the [Combinator], returning the
+// start and end byte offsets of the region it consumed. The matched value is
+// discarded. Offsets are relative to the text provided to the combinator,
+// with the start being inclusive and the end exclusive, allowing them to
+// be used to slice the input text.
+//
+//	chomp.Spanned(chomp.Tag("Hello"))("Hello, World!")
+//	// (", World!", 0, 5, nil)
+func Spanned[T any](c Combinator[T]) func(string) (string, int, int, error) {
+	return func(s string) (string, int, int, error) {
+		rem, _, err := c(s)
+		if err != nil {
+			return rem, 0, 0, err
+		}
+
+		return rem, 0, len(s) - len(rem), nil
+	}
+}
+
+// Source tracks the original input text provided to a parser. It precomputes
+// an index of all line endings, allowing a byte offset to be efficiently
+// translated into a line and column.
+type Source struct {
+	text  string
+	lines []int
+}
+
+// NewSource creates a [Source] for the original input text.
+func NewSource(text string) *Source {
+	lines := []int{0}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+
+	return &Source{text: text, lines: lines}
+}
+
+// Offset returns the byte offset of the remaining text within the original
+// input text. As combinators only ever consume from the front of the text,
+// any remaining text will always be a suffix of the original.
+func (src *Source) Offset(rem string) int {
+	return len(src.text) - len(rem)
+}
+
+// Position translates a byte offset within the original input text into
+// a [Position]. Offsets outside of the original input text are clamped.
+func (src *Source) Position(offset int) Position {
+	if offset < 0 {
+		offset = 0
+	}
+
+	if offset > len(src.text) {
+		offset = len(src.text)
+	}
+
+	line := sort.Search(len(src.lines), func(i int) bool { return src.lines[i] > offset }) - 1
+	return Position{
+		Offset: offset,
+		Line:   line + 1,
+		Column: utf8.RuneCountInString(src.text[src.lines[line]:offset]) + 1,
+	}
+}
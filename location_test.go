@@ -0,0 +1,99 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocated(t *testing.T) {
+	t.Parallel()
+
+	input := "Hello,\nこんにちは!"
+	rem, span, ext, err := chomp.Located(chomp.NewSource(input), chomp.Until("!"))(input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "!", rem)
+	assert.Equal(t, "Hello,\nこんにちは", ext)
+	assert.Equal(t, chomp.Position{Offset: 0, Line: 1, Column: 1}, span.Start)
+	assert.Equal(t, chomp.Position{Offset: 22, Line: 2, Column: 6}, span.End)
+}
+
+func TestLocatedError(t *testing.T) {
+	t.Parallel()
+
+	input := "Hello, World!"
+	_, span, _, err := chomp.Located(chomp.NewSource(input), chomp.Tag("Goodbye"))(input)
+
+	require.Error(t, err)
+	assert.Equal(t, chomp.Span{}, span)
+}
+
+func TestLocatedRelativeToOriginalInput(t *testing.T) {
+	t.Parallel()
+
+	input := "[header]\nkey = value\nこんにちは = world"
+	src := chomp.NewSource(input)
+
+	rem, _, err := chomp.Until("こんにちは")(input)
+	require.NoError(t, err)
+
+	_, span, _, err := chomp.Located(src, chomp.Tag("こんにちは = world"))(rem)
+	require.NoError(t, err)
+
+	assert.Equal(t, chomp.Position{Offset: 21, Line: 3, Column: 1}, span.Start)
+	assert.Equal(t, chomp.Position{Offset: len(input), Line: 3, Column: 14}, span.End)
+	assert.Equal(t, "3:1-3:14", span.String())
+}
+
+func TestLocatedNested(t *testing.T) {
+	t.Parallel()
+
+	input := "abc"
+	src := chomp.NewSource(input)
+
+	var spans []chomp.Span
+	located := func(c chomp.Combinator[string]) chomp.Combinator[string] {
+		return func(s string) (string, string, error) {
+			rem, span, ext, err := chomp.Located(src, c)(s)
+			spans = append(spans, span)
+			return rem, ext, err
+		}
+	}
+
+	_, _, err := chomp.All(located(chomp.Tag("a")), located(chomp.Tag("b")), located(chomp.Tag("c")))(input)
+	require.NoError(t, err)
+
+	require.Len(t, spans, 3)
+	assert.Equal(t, "1:1-1:2", spans[0].String())
+	assert.Equal(t, "1:2-1:3", spans[1].String())
+	assert.Equal(t, "1:3-1:4", spans[2].String())
+	assert.Equal(t, 1, spans[1].Start.Offset)
+}
+
+func TestSourcePosition(t *testing.T) {
+	t.Parallel()
+
+	src := chomp.NewSource("Hello\n\nWorld")
+
+	assert.Equal(t, chomp.Position{Offset: 0, Line: 1, Column: 1}, src.Position(0))
+	assert.Equal(t, chomp.Position{Offset: 5, Line: 1, Column: 6}, src.Position(5))
+	assert.Equal(t, chomp.Position{Offset: 6, Line: 2, Column: 1}, src.Position(6))
+	assert.Equal(t, chomp.Position{Offset: 9, Line: 3, Column: 3}, src.Position(9))
+	assert.Equal(t, chomp.Position{Offset: 12, Line: 3, Column: 6}, src.Position(100))
+}
+
+func TestSpanned(t *testing.T) {
+	t.Parallel()
+
+	input := "こんにちは, World!"
+	rem, start, end, err := chomp.Spanned(chomp.Many(chomp.OneOf("こんにちは")))(input)
+
+	require.NoError(t, err)
+	assert.Equal(t, ", World!", rem)
+	assert.Equal(t, 0, start)
+	assert.Equal(t, 15, end)
+	assert.Equal(t, "こんにちは", input[start:end])
+}
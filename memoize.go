@@ -0,0 +1,63 @@
+package chomp
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// memoKey identifies a position within the input text. Holding a pointer to
+// the underlying memory prevents it from being reclaimed and reused by another
+// string, which would invalidate the key
+type memoKey struct {
+	data *byte
+	len  int
+}
+
+type memoEntry[T any] struct {
+	rem string
+	ext T
+	err error
+}
+
+// Memoize caches the result of the [Combinator] against each input text it
+// parses. As combinators only ever consume from the front of the text, the
+// remaining text is always a slice of the original input, uniquely identified
+// by its position in memory and length. Any repeated call at the same position
+// will return the cached result without invoking the [Combinator] again. This
+// enables packrat-style parsing of grammars that would otherwise backtrack over
+// the same text many times. It is safe for concurrent use.
+//
+// The cache is never evicted and will hold a reference to all text it has parsed.
+// A memoized combinator should therefore be created for each parse, rather than
+// being shared at the package level.
+//
+//	expr := chomp.Memoize(chomp.Tag("Hello"))
+//	chomp.First(chomp.Pair(expr, chomp.Tag("!")), chomp.Pair(expr, chomp.Tag(",")))("Hello, World!")
+//	// (" World!", []string{"Hello", ","}, nil)
+func Memoize[T any](c Combinator[T]) Combinator[T] {
+	var mu sync.Mutex
+	cache := map[memoKey]memoEntry[T]{}
+
+	return func(s string) (string, T, error) {
+		key := memoKey{
+			data: unsafe.StringData(s),
+			len:  len(s),
+		}
+
+		mu.Lock()
+		entry, ok := cache[key]
+		mu.Unlock()
+
+		if ok {
+			return entry.rem, entry.ext, entry.err
+		}
+
+		rem, ext, err := c(s)
+
+		mu.Lock()
+		cache[key] = memoEntry[T]{rem: rem, ext: ext, err: err}
+		mu.Unlock()
+
+		return rem, ext, err
+	}
+}
@@ -0,0 +1,68 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoize(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	counted := func(s string) (string, string, error) {
+		calls++
+		return chomp.Tag("Hello")(s)
+	}
+
+	greeting := chomp.Memoize[string](counted)
+	rem, ext, err := chomp.First(
+		chomp.Pair(greeting, chomp.Tag("!")),
+		chomp.Pair(greeting, chomp.Tag(";")),
+		chomp.Pair(greeting, chomp.Tag(",")))("Hello, World!")
+
+	require.NoError(t, err)
+	assert.Equal(t, " World!", rem)
+	assert.Equal(t, []string{"Hello", ","}, ext)
+	assert.Equal(t, 1, calls)
+}
+
+func TestMemoizeDistinguishesPositions(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	counted := func(s string) (string, string, error) {
+		calls++
+		return chomp.Tag("ha")(s)
+	}
+
+	laugh := chomp.Memoize[string](counted)
+	rem, ext, err := chomp.Many(laugh)("hahaha!")
+
+	require.NoError(t, err)
+	assert.Equal(t, "!", rem)
+	assert.Equal(t, []string{"ha", "ha", "ha"}, ext)
+	assert.Equal(t, 4, calls)
+}
+
+func TestMemoizeCachesErrors(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	counted := func(s string) (string, string, error) {
+		calls++
+		return chomp.Tag("Goodbye")(s)
+	}
+
+	farewell := chomp.Memoize[string](counted)
+	input := "Hello, World!"
+
+	_, _, err := farewell(input)
+	require.Error(t, err)
+
+	_, _, err = farewell(input)
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
@@ -3,6 +3,7 @@ package chomp
 import (
 	"fmt"
 	"strings"
+	"sync"
 )
 
 // MappedCombinator is a function capable of converting the output from a [Combinator]
@@ -10,7 +11,7 @@ import (
 // mapped value. All combinators are strict and must parse its input. Any failure to do
 // so should raise a [CombinatorParseError]. It is designed for exclusive use by the
 // [Map] function
-type MappedCombinator[S any, T Result] func(string) (string, S, error)
+type MappedCombinator[S, T any] func(string) (string, S, error)
 
 // Map the result of a [Combinator] to any other type
 //
@@ -18,7 +19,7 @@ type MappedCombinator[S any, T Result] func(string) (string, S, error)
 //		chomp.While(chomp.IsDigit),
 //		func (in string) int { return len(in) })("123456")
 //	// ("", 6, nil)
-func Map[S any, T Result](c Combinator[T], mapper func(in T) S) MappedCombinator[S, T] {
+func Map[S, T any](c Combinator[T], mapper func(in T) S) MappedCombinator[S, T] {
 	return func(s string) (string, S, error) {
 		var mapped S
 
@@ -32,18 +33,226 @@ func Map[S any, T Result](c Combinator[T], mapper func(in T) S) MappedCombinator
 	}
 }
 
+// MapErr maps the result of a [Combinator] to any other type, in the same way
+// as [Map]. If the mapper fails, its error is returned as a [ParserError],
+// along with the text remaining after the [Combinator] matched.
+//
+//	chomp.MapErr(
+//		chomp.While(chomp.IsDigit),
+//		func (in string) (int, error) { return strconv.Atoi(in) })("123456")
+//	// ("", 123456, nil)
+func MapErr[S, T any](c Combinator[T], mapper func(in T) (S, error)) MappedCombinator[S, T] {
+	return func(s string) (string, S, error) {
+		var mapped S
+
+		rem, out, err := c(s)
+		if err != nil {
+			return rem, mapped, err
+		}
+
+		if mapped, err = mapper(out); err != nil {
+			var def S
+			return rem, def, ParserError{Err: err, Type: "map_err"}
+		}
+
+		return rem, mapped, nil
+	}
+}
+
+// VerifyMap maps the result of a [Combinator] to any other type, in the same
+// way as [Map], and then verifies the mapped value using the predicate. If
+// the predicate rejects the value, no input text is consumed.
+//
+//	chomp.VerifyMap(
+//		chomp.While(chomp.IsDigit),
+//		func (in string) int { n, _ := strconv.Atoi(in); return n },
+//		func (n int) bool { return n >= 1 && n <= 12 })("12 months")
+//	// (" months", 12, nil)
+func VerifyMap[S, T any](c Combinator[T], mapper func(in T) S, pred func(S) bool) MappedCombinator[S, T] {
+	return func(s string) (string, S, error) {
+		var def S
+
+		rem, out, err := c(s)
+		if err != nil {
+			return rem, def, err
+		}
+
+		mapped := mapper(out)
+		if !pred(mapped) {
+			return s, def, CombinatorParseError{Text: s, Type: "verify_map"}
+		}
+
+		return rem, mapped, nil
+	}
+}
+
+// Value discards the result of a [Combinator] and returns a constant value
+// in its place. The matched text is still consumed.
+//
+//	chomp.Value(chomp.Tag("true"), true)("true, false")
+//	// (", false", true, nil)
+func Value[S, T any](c Combinator[T], v S) MappedCombinator[S, T] {
+	return func(s string) (string, S, error) {
+		rem, _, err := c(s)
+		if err != nil {
+			var def S
+			return rem, def, err
+		}
+
+		return rem, v, nil
+	}
+}
+
+// Replace the matched text of a [Combinator] with a constant value. It is
+// an alias of [Value] that reads more naturally when mapping keywords to
+// constants, such as an enum.
+//
+//	type Method int
+//	const (
+//		Get Method = iota
+//		Post
+//	)
+//
+//	chomp.Replace(chomp.Tag("POST"), Post)("POST /index.html")
+//	// (" /index.html", Post, nil)
+func Replace[S, T any](c Combinator[T], v S) MappedCombinator[S, T] {
+	return Value(c, v)
+}
+
+// FromMapped renders the result of a [MappedCombinator] back into a string,
+// allowing it to participate in any sequence combinator that requires a
+// [Result], such as [Pair] or [All]. Use [Pair2], [Tuple3] or [Tuple4] to
+// sequence mapped values without rendering them, by converting the
+// [MappedCombinator] into a [Combinator].
+//
+//	type Point struct{ X, Y int }
+//
+//	point := chomp.Map(
+//		chomp.SepPair(chomp.While(chomp.IsDigit), chomp.Tag(","), chomp.While(chomp.IsDigit)),
+//		func(in []string) Point { ... })
+//
+//	chomp.All(
+//		chomp.FromMapped(point, func(p Point) string { return fmt.Sprintf("(%d %d)", p.X, p.Y) }),
+//		chomp.Tag(" -> "),
+//		chomp.FromMapped(point, func(p Point) string { return fmt.Sprintf("(%d %d)", p.X, p.Y) }),
+//	)("1,2 -> 3,4")
+//	// ("", []string{"(1 2)", " -> ", "(3 4)"}, nil)
+func FromMapped[S, T any](c MappedCombinator[S, T], render func(S) string) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, ext, err := c(s)
+		if err != nil {
+			return rem, "", ParserError{Err: err, Type: "from_mapped"}
+		}
+
+		return rem, render(ext), nil
+	}
+}
+
 // Opt allows a [Combinator] to be optional by discarding its returned
 // error and not modifying the input text upon failure.
 //
 //	chomp.Opt(chomp.Tag("Hey"))("Hello, World!")
 //	// ("Hello, World!", "", nil)
-func Opt[T Result](c Combinator[T]) Combinator[T] {
+func Opt[T any](c Combinator[T]) Combinator[T] {
 	return func(s string) (string, T, error) {
 		rem, out, _ := c(s)
 		return rem, out, nil
 	}
 }
 
+// OptOr allows a [Combinator] to be optional, in the same way as [Opt], but
+// returns the provided default value upon failure. No input text is consumed
+// upon failure.
+//
+//	chomp.OptOr(chomp.While(chomp.IsDigit), "1")("lines")
+//	// ("lines", "1", nil)
+func OptOr[T any](c Combinator[T], def T) Combinator[T] {
+	return func(s string) (string, T, error) {
+		rem, out, err := c(s)
+		if err != nil {
+			return s, def, nil
+		}
+		return rem, out, nil
+	}
+}
+
+// Cond will only apply the [Combinator] if the condition is true. If
+// false, no input text is consumed and an empty value is returned. The
+// condition is evaluated eagerly when the combinator is constructed and
+// not against each input text.
+//
+//	chomp.Cond(true, chomp.Tag("Hello"))("Hello, World!")
+//	// (", World!", "Hello", nil)
+func Cond[T any](cond bool, c Combinator[T]) Combinator[T] {
+	return func(s string) (string, T, error) {
+		if !cond {
+			var out T
+			return s, out, nil
+		}
+		return c(s)
+	}
+}
+
+// CondElse will apply one of two [Combinator]s based on the condition.
+// If true, the first combinator is applied, otherwise the second. As with
+// [Cond], the condition is evaluated eagerly when the combinator is
+// constructed and not against each input text.
+//
+//	chomp.CondElse(false, chomp.Tag("Hello"), chomp.Tag("Goodbye"))("Goodbye, World!")
+//	// (", World!", "Goodbye", nil)
+func CondElse[T any](cond bool, whenTrue, whenFalse Combinator[T]) Combinator[T] {
+	if cond {
+		return whenTrue
+	}
+	return whenFalse
+}
+
+// Cut commits a parser to its current branch. Any failure of the
+// [Combinator] is wrapped within a [FatalError], preventing [First]
+// and [Alt] from backtracking and trying any remaining alternatives.
+// This results in a more precise error once a grammar rule has been
+// identified.
+//
+//	chomp.First(
+//		chomp.Pair(chomp.Tag("let "), chomp.Cut(chomp.Tag("x ="))),
+//		chomp.Pair(chomp.Tag("let"), chomp.Tag("ter")))("let y = 1")
+//	// ("let y = 1", nil, "(cut) parser failed. (tag) combinator failed...")
+func Cut[T any](c Combinator[T]) Combinator[T] {
+	return func(s string) (string, T, error) {
+		rem, ext, err := c(s)
+		if err != nil && !isFatal(err) {
+			err = FatalError{Err: err}
+		}
+		return rem, ext, err
+	}
+}
+
+// Lazy defers the construction of a [Combinator] until it is first used,
+// allowing a grammar to reference itself recursively. Construction happens
+// only once, and is safe for concurrent use.
+//
+// A left-recursive grammar, where a [Combinator] calls itself before consuming
+// any input text, will recurse indefinitely and overflow the stack. It must be
+// rewritten so that input is consumed before each recursive call, or parsed
+// with a helper such as [ChainLeft].
+//
+//	var expr chomp.Combinator[string]
+//	expr = chomp.First(
+//		chomp.While(chomp.IsDigit),
+//		chomp.Delimited(chomp.Tag("("), chomp.Lazy(func() chomp.Combinator[string] { return expr }), chomp.Tag(")")))
+//
+//	expr("((42))")
+//	// ("", "42", nil)
+func Lazy[T any](f func() Combinator[T]) Combinator[T] {
+	var once sync.Once
+	var c Combinator[T]
+
+	return func(s string) (string, T, error) {
+		once.Do(func() { c = f() })
+		return c(s)
+	}
+}
+
 // S wraps the result of the inner [Combinator] within a string slice.
 // Combinators of differing return types can be successfully chained
 // together while using this conversion combinator.
@@ -98,15 +307,66 @@ func I(c Combinator[[]string], i int) Combinator[string] {
 //		chomp.Many(chomp.Suffixed(chomp.Tag(" "), chomp.Until(" "))),
 //	)("Hello and Good Morning!")
 //	// ("Hello and Good Morning!", []string{"Hello", "and", "Good"}, nil)
-func Peek[T Result](c Combinator[T]) Combinator[T] {
+func Peek[T any](c Combinator[T]) Combinator[T] {
 	return func(s string) (string, T, error) {
 		_, ext, err := c(s)
 		return s, ext, err
 	}
 }
 
+// Label annotates any error raised by the [Combinator] with a human-readable
+// name. Useful for identifying which grammar rule failed when combinators
+// are deeply nested. The original error is wrapped within a [LabelError].
+//
+//	chomp.Label("greeting", chomp.Tag("Hello"))("Good Morning!")
+//	// ("Good Morning!", "", `while parsing "greeting": (tag) combinator failed to parse text 'Good Morning!' with input 'Hello'`)
+func Label[T any](name string, c Combinator[T]) Combinator[T] {
+	return func(s string) (string, T, error) {
+		rem, ext, err := c(s)
+		if err != nil {
+			return rem, ext, LabelError{Err: err, Label: name}
+		}
+
+		return rem, ext, nil
+	}
+}
+
+// FollowedBy will scan the text and apply the [Combinator] without consuming
+// any input. It only succeeds if the [Combinator] matches, returning an empty
+// string. Unlike [Peek], the matched value is discarded, making it suitable
+// as an assertion within a sequence of combinators.
+//
+//	chomp.FollowedBy(chomp.Tag("Hello"))("Hello, World!")
+//	// ("Hello, World!", "", nil)
+func FollowedBy[T any](c Combinator[T]) Combinator[string] {
+	return func(s string) (string, string, error) {
+		if _, _, err := c(s); err != nil {
+			return s, "", ParserError{Err: err, Type: "followed_by"}
+		}
+
+		return s, "", nil
+	}
+}
+
+// NotFollowedBy will scan the text and apply the [Combinator] without
+// consuming any input. It only succeeds if the [Combinator] fails to match,
+// returning an empty string. Useful for asserting what must not come next.
+//
+//	chomp.NotFollowedBy(chomp.Tag("Good"))("Hello, World!")
+//	// ("Hello, World!", "", nil)
+func NotFollowedBy[T any](c Combinator[T]) Combinator[string] {
+	return func(s string) (string, string, error) {
+		if _, _, err := c(s); err == nil {
+			return s, "", CombinatorParseError{Text: s, Type: "not_followed_by"}
+		}
+
+		return s, "", nil
+	}
+}
+
 // Flatten the output from a [Combinator] by joining all extracted values
-// into a string.
+// into a string. Values are concatenated without a separator. Use [JoinWith]
+// to join values with a separator.
 //
 //	chomp.Flatten(
 //		chomp.Many(chomp.Parentheses()),
@@ -121,3 +381,91 @@ func Flatten(c Combinator[[]string]) Combinator[string] {
 		return rem, strings.Join(ext, ""), nil
 	}
 }
+
+// JoinWith the output from a [Combinator] by joining all extracted values
+// into a string, placing the separator between each value. Unlike [Flatten],
+// values remain distinguishable after being joined.
+//
+//	chomp.JoinWith(
+//		chomp.Many(chomp.Parentheses()),
+//		", ",
+//	)("(H)(el)(lo), World!")
+//	// (", World!", "H, el, lo", nil)
+func JoinWith(c Combinator[[]string], sep string) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, ext, err := c(s)
+		if err != nil {
+			return rem, "", ParserError{Err: err, Type: "join_with"}
+		}
+		return rem, strings.Join(ext, sep), nil
+	}
+}
+
+// Trimmed will trim any leading and trailing whitespace, as defined by
+// [unicode.IsSpace], from the output of a [Combinator]. If multiple values
+// are extracted, they are first joined into a string, in the same way as
+// [Flatten]. Only the output is trimmed, the input text is left untouched.
+// Use [Ws] to discard whitespace from the input text.
+//
+//	chomp.Trimmed(chomp.Until(";"))("  Hello, World!  ;")
+//	// (";", "Hello, World!", nil)
+func Trimmed[T Result](c Combinator[T]) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, ext, err := c(s)
+		if err != nil {
+			return rem, "", ParserError{Err: err, Type: "trimmed"}
+		}
+		return rem, strings.TrimSpace(strings.Join(combine(nil, ext), "")), nil
+	}
+}
+
+// Ws will discard any whitespace, including line endings, from the input
+// text both before and after matching a [Combinator]. Unlike [Trimmed], the
+// whitespace is consumed, making it ideal for matching tokens that can be
+// separated by any amount of whitespace. If the [Combinator] fails, no input
+// text is consumed. It is the equivalent of calling [Between] with [Multispace0].
+//
+//	chomp.Ws(chomp.Tag("Hello"))("  Hello  , World!")
+//	// (", World!", "Hello", nil)
+func Ws[T any](c Combinator[T]) Combinator[T] {
+	return Between(Multispace0(), c)
+}
+
+// Recognize will apply the [Combinator] and return the raw text that it
+// consumed, discarding its parsed value. This is useful for capturing the
+// original text of a combinator that transforms or splits its input.
+//
+//	chomp.Recognize(
+//		chomp.SepPair(chomp.While(chomp.IsDigit), chomp.Tag("."), chomp.While(chomp.IsDigit)),
+//	)("3.14 is pi")
+//	// (" is pi", "3.14", nil)
+func Recognize[T any](c Combinator[T]) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, _, err := c(s)
+		if err != nil {
+			return rem, "", ParserError{Err: err, Type: "recognize"}
+		}
+
+		return rem, s[:len(s)-len(rem)], nil
+	}
+}
+
+// Consumed will apply the [Combinator] and return both its parsed value and
+// the raw text that it consumed, as a typed [Values2]. Unlike [Recognize],
+// the parsed value is retained, allowing a token to carry both its decoded
+// value and its original text.
+//
+//	chomp.Consumed(chomp.EscapedTransform('"', '\\', func(r rune) (string, error) {
+//		return string(r), nil
+//	}))(`"a \"quote\"" here`)
+//	// (" here", chomp.Values2[string, string]{First: `a "quote"`, Second: `"a \"quote\""`}, nil)
+func Consumed[T any](c Combinator[T]) Combinator[Values2[T, string]] {
+	return func(s string) (string, Values2[T, string], error) {
+		rem, ext, err := c(s)
+		if err != nil {
+			return rem, Values2[T, string]{}, ParserError{Err: err, Type: "consumed"}
+		}
+
+		return rem, Values2[T, string]{First: ext, Second: s[:len(s)-len(rem)]}, nil
+	}
+}
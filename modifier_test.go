@@ -1,6 +1,7 @@
 package chomp_test
 
 import (
+	"fmt"
 	"strconv"
 	"testing"
 
@@ -32,6 +33,107 @@ func TestMap(t *testing.T) {
 	assert.Equal(t, 2, out.Y)
 }
 
+func TestMapErr(t *testing.T) {
+	t.Parallel()
+
+	rem, out, err := chomp.MapErr(
+		chomp.While(chomp.IsDigit),
+		func(in string) (int, error) { return strconv.Atoi(in) },
+	)("123456 apples")
+
+	require.NoError(t, err)
+	assert.Equal(t, " apples", rem)
+	assert.Equal(t, 123456, out)
+}
+
+func TestMapErrMapperFails(t *testing.T) {
+	t.Parallel()
+
+	rem, out, err := chomp.MapErr(
+		chomp.While(chomp.IsDigit),
+		func(in string) (int8, error) {
+			n, err := strconv.ParseInt(in, 10, 8)
+			return int8(n), err
+		},
+	)("1024 apples")
+
+	require.EqualError(t, err, `(map_err) parser failed. strconv.ParseInt: parsing "1024": value out of range`)
+	assert.ErrorIs(t, err, strconv.ErrRange)
+	assert.Equal(t, " apples", rem)
+	assert.Equal(t, int8(0), out)
+}
+
+func TestVerifyMap(t *testing.T) {
+	t.Parallel()
+
+	month := chomp.VerifyMap(
+		chomp.While(chomp.IsDigit),
+		func(in string) int { n, _ := strconv.Atoi(in); return n },
+		func(n int) bool { return n >= 1 && n <= 12 },
+	)
+
+	rem, ext, err := month("12 months")
+
+	require.NoError(t, err)
+	assert.Equal(t, " months", rem)
+	assert.Equal(t, 12, ext)
+}
+
+func TestVerifyMapRejected(t *testing.T) {
+	t.Parallel()
+
+	month := chomp.VerifyMap(
+		chomp.While(chomp.IsDigit),
+		func(in string) int { n, _ := strconv.Atoi(in); return n },
+		func(n int) bool { return n >= 1 && n <= 12 },
+	)
+
+	rem, ext, err := month("13 months")
+
+	require.EqualError(t, err, "(verify_map) combinator failed to parse text '13 months'")
+	assert.Equal(t, "13 months", rem)
+	assert.Equal(t, 0, ext)
+}
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Value(chomp.Tag("true"), true)("true, false")
+
+	require.NoError(t, err)
+	assert.Equal(t, ", false", rem)
+	assert.True(t, ext)
+}
+
+func TestValueNoMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Value(chomp.Tag("true"), true)("false, true")
+
+	require.Error(t, err)
+	assert.Equal(t, "false, true", rem)
+	assert.False(t, ext)
+}
+
+func TestReplace(t *testing.T) {
+	t.Parallel()
+
+	type method int
+	const (
+		get method = iota + 1
+		post
+	)
+
+	rem, ext, err := chomp.First(
+		chomp.Combinator[method](chomp.Replace(chomp.Tag("GET"), get)),
+		chomp.Combinator[method](chomp.Replace(chomp.Tag("POST"), post)),
+	)("POST /index.html")
+
+	require.NoError(t, err)
+	assert.Equal(t, " /index.html", rem)
+	assert.Equal(t, post, ext)
+}
+
 func TestOpt(t *testing.T) {
 	t.Parallel()
 
@@ -42,6 +144,145 @@ func TestOpt(t *testing.T) {
 	assert.Equal(t, "", ext)
 }
 
+func TestOptOr(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.OptOr(chomp.Tag("the"), "a")("dark knight")
+
+	require.NoError(t, err)
+	assert.Equal(t, "dark knight", rem)
+	assert.Equal(t, "a", ext)
+}
+
+func TestOptOrMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.OptOr(chomp.Tag("the"), "a")("the dark knight")
+
+	require.NoError(t, err)
+	assert.Equal(t, " dark knight", rem)
+	assert.Equal(t, "the", ext)
+}
+
+func TestOptOrNoPartialConsumption(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.OptOr(
+		chomp.Pair(chomp.Tag(","), chomp.While(chomp.IsDigit)),
+		[]string{",", "1"},
+	)(",lines")
+
+	require.NoError(t, err)
+	assert.Equal(t, ",lines", rem)
+	assert.Equal(t, []string{",", "1"}, ext)
+}
+
+func TestCond(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		cond        bool
+		expectedRem string
+		expectedExt string
+	}{
+		{
+			name:        "True",
+			cond:        true,
+			expectedRem: ", World!",
+			expectedExt: "Hello",
+		},
+		{
+			name:        "False",
+			cond:        false,
+			expectedRem: "Hello, World!",
+			expectedExt: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.Cond(tt.cond, chomp.Tag("Hello"))("Hello, World!")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRem, rem)
+			assert.Equal(t, tt.expectedExt, ext)
+		})
+	}
+}
+
+func TestCondElse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		cond        bool
+		input       string
+		expectedExt []string
+	}{
+		{
+			name:        "True",
+			cond:        true,
+			input:       "chunked:5",
+			expectedExt: []string{"chunked", "5"},
+		},
+		{
+			name:        "False",
+			cond:        false,
+			input:       "5",
+			expectedExt: []string{"5"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.CondElse(
+				tt.cond,
+				chomp.SepPair(chomp.Tag("chunked"), chomp.Tag(":"), chomp.While(chomp.IsDigit)),
+				chomp.S(chomp.While(chomp.IsDigit)))(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, "", rem)
+			assert.Equal(t, tt.expectedExt, ext)
+		})
+	}
+}
+
+func TestCondElseFailsOnSelectedBranch(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.CondElse(true, chomp.Tag("Hello"), chomp.Tag("Goodbye"))("Goodbye, World!")
+
+	require.Error(t, err)
+	assert.Equal(t, "Goodbye, World!", rem)
+}
+
+func TestCut(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Cut(chomp.Tag("let"))("let x = 1")
+
+	require.NoError(t, err)
+	assert.Equal(t, " x = 1", rem)
+	assert.Equal(t, "let", ext)
+}
+
+func TestCutFatalError(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.Cut(chomp.Cut(chomp.Tag("let")))("var x = 1")
+
+	var fatal chomp.FatalError
+	require.ErrorAs(t, err, &fatal)
+	assert.EqualError(t, err, "(cut) parser failed. (tag) combinator failed to parse text 'var x = 1' with input 'let'")
+}
+
 func TestS(t *testing.T) {
 	t.Parallel()
 
@@ -96,3 +337,286 @@ func TestFlatten(t *testing.T) {
 	assert.Equal(t, " and Good Morning!", rem)
 	assert.Equal(t, "Hello", ext)
 }
+
+func TestJoinWith(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.JoinWith(
+		chomp.Many(chomp.Parentheses()),
+		", ",
+	)("(H)(el)(lo) and Good Morning!")
+
+	require.NoError(t, err)
+	assert.Equal(t, " and Good Morning!", rem)
+	assert.Equal(t, "H, el, lo", ext)
+}
+
+func TestJoinWithNoMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.JoinWith(chomp.Many(chomp.Parentheses()), ", ")("Good Morning!")
+
+	require.Error(t, err)
+	assert.Equal(t, "Good Morning!", rem)
+	assert.Empty(t, ext)
+}
+
+func TestRecognize(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Recognize(
+		chomp.SepPair(chomp.While(chomp.IsDigit), chomp.Tag("."), chomp.While(chomp.IsDigit)),
+	)("3.14 is pi")
+
+	require.NoError(t, err)
+	assert.Equal(t, " is pi", rem)
+	assert.Equal(t, "3.14", ext)
+}
+
+func TestRecognizeNoMatch(t *testing.T) {
+	t.Parallel()
+
+	_, ext, err := chomp.Recognize(chomp.Tag("pi"))("3.14 is pi")
+
+	require.EqualError(t, err, "(recognize) parser failed. (tag) combinator failed to parse text '3.14 is pi' with input 'pi'")
+	assert.Empty(t, ext)
+}
+
+func TestConsumed(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Consumed(
+		chomp.Combinator[int](chomp.MapErr(chomp.While(chomp.IsDigit), strconv.Atoi)),
+	)("0042 apples")
+
+	require.NoError(t, err)
+	assert.Equal(t, " apples", rem)
+	assert.Equal(t, 42, ext.First)
+	assert.Equal(t, "0042", ext.Second)
+}
+
+func TestConsumedNoMatch(t *testing.T) {
+	t.Parallel()
+
+	_, ext, err := chomp.Consumed(chomp.Tag("pi"))("3.14 is pi")
+
+	require.EqualError(t, err, "(consumed) parser failed. (tag) combinator failed to parse text '3.14 is pi' with input 'pi'")
+	assert.Empty(t, ext.First)
+	assert.Empty(t, ext.Second)
+}
+
+func TestLabel(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.Label("header", chomp.Pair(chomp.Tag("["), chomp.Tag("]")))("[core")
+
+	require.Error(t, err)
+	assert.Equal(t, `while parsing "header": (pair) parser failed. (tag) combinator failed to parse text 'core' with input ']'`, err.Error())
+
+	var labelErr chomp.LabelError
+	require.ErrorAs(t, err, &labelErr)
+	assert.Equal(t, "header", labelErr.Label)
+
+	var combErr chomp.CombinatorParseError
+	require.ErrorAs(t, err, &combErr)
+	assert.Equal(t, "tag", combErr.Type)
+}
+
+func TestNotFollowedBy(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.NotFollowedBy(chomp.Tag("the"))("dark knight")
+
+	require.NoError(t, err)
+	assert.Equal(t, "dark knight", rem)
+	assert.Equal(t, "", ext)
+}
+
+func TestNotFollowedByMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.Pair(
+		chomp.Tag("if"),
+		chomp.NotFollowedBy(chomp.While(chomp.IsAlphanumeric)))("iffy")
+
+	require.Error(t, err)
+	assert.Equal(t, "fy", rem)
+}
+
+func TestFollowedBy(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.All(
+		chomp.Tag("dark"),
+		chomp.FollowedBy(chomp.Tag(" knight")),
+		chomp.Until("!"))("dark knight!")
+
+	require.NoError(t, err)
+	assert.Equal(t, "!", rem)
+	assert.Equal(t, []string{"dark", "", " knight"}, ext)
+}
+
+func TestFollowedByNoMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.FollowedBy(chomp.Tag("the"))("dark knight")
+
+	require.Error(t, err)
+	assert.Equal(t, "dark knight", rem)
+	assert.EqualError(t, err, "(followed_by) parser failed. (tag) combinator failed to parse text 'dark knight' with input 'the'")
+}
+
+func TestTrimmed(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Trimmed(chomp.Until(";"))(" \tHello, World!  ;")
+
+	require.NoError(t, err)
+	assert.Equal(t, ";", rem)
+	assert.Equal(t, "Hello, World!", ext)
+}
+
+func TestTrimmedMultipleValues(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Trimmed(
+		chomp.Pair(chomp.Tag("  Hello"), chomp.Tag(", World!  ")),
+	)("  Hello, World!  ;")
+
+	require.NoError(t, err)
+	assert.Equal(t, ";", rem)
+	assert.Equal(t, "Hello, World!", ext)
+}
+
+func TestWs(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Ws(chomp.Tag("Hello"))(" \r\n\tHello \n, World!")
+
+	require.NoError(t, err)
+	assert.Equal(t, ", World!", rem)
+	assert.Equal(t, "Hello", ext)
+}
+
+func TestWsNoWhitespace(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Ws(chomp.Tag("Hello"))("Hello, World!")
+
+	require.NoError(t, err)
+	assert.Equal(t, ", World!", rem)
+	assert.Equal(t, "Hello", ext)
+}
+
+func TestWsNoMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.Ws(chomp.Tag("Goodbye"))("  Hello, World!")
+
+	require.Error(t, err)
+	assert.Equal(t, "  Hello, World!", rem)
+	assert.EqualError(t, err, "(between) parser failed. (tag) combinator failed to parse text 'Hello, World!' with input 'Goodbye'")
+}
+
+type point struct{ X, Y int }
+
+func pointParser() chomp.MappedCombinator[point, []string] {
+	return chomp.Map(
+		chomp.SepPair(chomp.While(chomp.IsDigit), chomp.Tag(","), chomp.While(chomp.IsDigit)),
+		func(in []string) point {
+			x, _ := strconv.Atoi(in[0])
+			y, _ := strconv.Atoi(in[1])
+			return point{X: x, Y: y}
+		})
+}
+
+func TestFromMapped(t *testing.T) {
+	t.Parallel()
+
+	render := func(p point) string { return fmt.Sprintf("(%d %d)", p.X, p.Y) }
+
+	rem, ext, err := chomp.All(
+		chomp.FromMapped(pointParser(), render),
+		chomp.Tag(" -> "),
+		chomp.FromMapped(pointParser(), render),
+	)("1,2 -> 3,4")
+
+	require.NoError(t, err)
+	assert.Equal(t, "", rem)
+	assert.Equal(t, []string{"(1 2)", " -> ", "(3 4)"}, ext)
+}
+
+func TestFromMappedError(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.FromMapped(pointParser(), func(p point) string { return "" })("a,b")
+
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "(from_mapped) parser failed")
+}
+
+func TestMappedTypedSequence(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Tuple3(
+		chomp.Combinator[point](pointParser()),
+		chomp.Tag(" -> "),
+		chomp.Combinator[point](pointParser()),
+	)("1,2 -> 3,4")
+
+	require.NoError(t, err)
+	assert.Equal(t, "", rem)
+	assert.Equal(t, point{X: 1, Y: 2}, ext.First)
+	assert.Equal(t, point{X: 3, Y: 4}, ext.Third)
+}
+
+func TestLazy(t *testing.T) {
+	t.Parallel()
+
+	var expr chomp.Combinator[string]
+	expr = chomp.First(
+		chomp.While(chomp.IsDigit),
+		chomp.Delimited(
+			chomp.Tag("("),
+			chomp.Lazy(func() chomp.Combinator[string] { return expr }),
+			chomp.Tag(")")))
+
+	rem, ext, err := expr("((42)) + 1")
+
+	require.NoError(t, err)
+	assert.Equal(t, " + 1", rem)
+	assert.Equal(t, "42", ext)
+}
+
+func TestLazyConstructsOnce(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	lazy := chomp.Lazy(func() chomp.Combinator[string] {
+		calls++
+		return chomp.Tag("Hello")
+	})
+	assert.Equal(t, 0, calls)
+
+	for i := 0; i < 3; i++ {
+		_, _, err := lazy("Hello, World!")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestLazyUnbalanced(t *testing.T) {
+	t.Parallel()
+
+	var expr chomp.Combinator[string]
+	expr = chomp.First(
+		chomp.While(chomp.IsDigit),
+		chomp.Delimited(
+			chomp.Tag("("),
+			chomp.Lazy(func() chomp.Combinator[string] { return expr }),
+			chomp.Tag(")")))
+
+	_, _, err := expr("((42)")
+
+	require.Error(t, err)
+}
@@ -0,0 +1,398 @@
+package chomp
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// HostsEntry contains the individual parts of a parsed hosts file line.
+type HostsEntry struct {
+	// IP address that each hostname resolves to.
+	IP netip.Addr
+
+	// Names contains each hostname, including aliases, for the IP address.
+	Names []string
+}
+
+type isHostsText struct{}
+
+func (isHostsText) Match(r rune) bool {
+	return r != ' ' && r != '\t' && r != '#' && r != '\r' && r != '\n'
+}
+
+func (isHostsText) String() string {
+	return "is_hosts_text"
+}
+
+// HostsLine will parse a single line of a hosts file, such as /etc/hosts. An
+// IP address (IPv4 or IPv6) must be followed by at least one whitespace
+// separated hostname. An optional trailing comment (#) is discarded. The line
+// ending is consumed.
+//
+//	chomp.HostsLine()("127.0.0.1 localhost local # loopback\n")
+//	// ("", chomp.HostsEntry{IP: netip.MustParseAddr("127.0.0.1"), Names: []string{"localhost", "local"}}, nil)
+func HostsLine() Combinator[HostsEntry] {
+	return func(s string) (string, HostsEntry, error) {
+		var entry HostsEntry
+
+		rem, addr, err := Prefixed(While(isHostsText{}), WhileN(isBlank{}, 0))(s)
+		if err != nil {
+			return s, entry, ParserError{Err: err, Type: "hosts_line"}
+		}
+
+		if entry.IP, err = netip.ParseAddr(addr); err != nil {
+			return s, entry, ParserError{Err: err, Type: "hosts_line"}
+		}
+
+		rem, names, err := Many(Prefixed(While(isHostsText{}), While(isBlank{})))(rem)
+		if err != nil {
+			return s, entry, ParserError{Err: err, Type: "hosts_line"}
+		}
+		entry.Names = names
+
+		rem, _, _ = WhileN(isBlank{}, 0)(rem)
+		if strings.HasPrefix(rem, "#") {
+			rem, _, _ = WhileNotN(IsLineEnding, 0)(rem)
+		}
+
+		if rem != "" {
+			if rem, _, err = Crlf()(rem); err != nil {
+				return s, HostsEntry{}, ParserError{Err: err, Type: "hosts_line"}
+			}
+		}
+
+		return rem, entry, nil
+	}
+}
+
+// HostsFile will parse every line of a hosts file, such as /etc/hosts,
+// using [HostsLine]. Blank lines and lines only containing a comment (#)
+// are skipped. The entire input is parsed.
+//
+//	chomp.HostsFile()("# loopback\n127.0.0.1 localhost\n\n::1 localhost\n")
+//	// ("", []chomp.HostsEntry{{IP: 127.0.0.1, Names: ["localhost"]}, {IP: ::1, Names: ["localhost"]}}, nil)
+func HostsFile() Combinator[[]HostsEntry] {
+	return func(s string) (string, []HostsEntry, error) {
+		var entries []HostsEntry
+
+		rem := s
+		for rem != "" {
+			var line string
+			if _, line, _ = Eol()(rem); strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimLeft(line, " \t"), "#") {
+				rem, _, _ = Eol()(rem)
+				continue
+			}
+
+			var entry HostsEntry
+			var err error
+			if rem, entry, err = HostsLine()(rem); err != nil {
+				return rem, nil, ParserError{Err: err, Type: "hosts_file"}
+			}
+			entries = append(entries, entry)
+		}
+
+		return rem, entries, nil
+	}
+}
+
+// ResolvConf will parse a resolver configuration file, such as /etc/resolv.conf,
+// into a map of directives. Each directive maps to all of its whitespace separated
+// values, with repeated directives, such as nameserver, collected in the order they
+// appear. A nameserver must be a valid IP address. Each option must either be a
+// name (rotate) or a name:value pair (ndots:2). Blank lines and comments ('#' or ';')
+// are skipped. The entire input is parsed.
+//
+//	chomp.ResolvConf()("nameserver 1.1.1.1\nsearch example.com\noptions ndots:2 rotate\n")
+//	// ("", map[string][]string{"nameserver": {"1.1.1.1"}, "search": {"example.com"}, "options": {"ndots:2", "rotate"}}, nil)
+func ResolvConf() Combinator[map[string][]string] {
+	return func(s string) (string, map[string][]string, error) {
+		conf := map[string][]string{}
+
+		rem := s
+		for rem != "" {
+			var line string
+			rem, line, _ = Eol()(rem)
+
+			if idx := strings.IndexAny(line, "#;"); idx != -1 {
+				line = line[:idx]
+			}
+
+			fields := strings.Fields(line)
+			if len(fields) == 0 {
+				continue
+			}
+
+			directive, values := fields[0], fields[1:]
+			if len(values) == 0 {
+				return s, nil, CombinatorParseError{Input: directive, Text: line, Type: "resolv_conf"}
+			}
+
+			for _, value := range values {
+				if err := resolvConfValue(directive, value); err != nil {
+					return s, nil, ParserError{Err: err, Type: "resolv_conf"}
+				}
+			}
+			conf[directive] = append(conf[directive], values...)
+		}
+
+		return "", conf, nil
+	}
+}
+
+func resolvConfValue(directive, value string) error {
+	switch directive {
+	case "nameserver":
+		_, err := netip.ParseAddr(value)
+		return err
+	case "options":
+		name, val, found := strings.Cut(value, ":")
+		if name == "" || (found && (val == "" || strings.Contains(val, ":"))) {
+			return CombinatorParseError{Input: directive, Text: value, Type: "resolv_conf_option"}
+		}
+	}
+
+	return nil
+}
+
+// HostPortParts contains the individual parts of a parsed host and port.
+type HostPortParts struct {
+	// Host is either a hostname or an IP address. An IPv6 address is returned
+	// without its enclosing brackets.
+	Host string
+
+	// Port number. It will be zero if not provided.
+	Port int
+}
+
+type isHostText struct{}
+
+func (isHostText) Match(r rune) bool {
+	return !unicode.IsSpace(r) && !strings.ContainsRune(":/?#[]@", r)
+}
+
+func (isHostText) String() string {
+	return "is_host_text"
+}
+
+// HostPort will parse a host with an optional port, host:port. An IPv6 address
+// must be enclosed within brackets, [::1]:8080, ensuring any colon within the
+// address is not mistaken for the port separator. If a port separator is present,
+// it must be followed by a valid port number.
+//
+//	chomp.HostPort()("[2001:db8::1]:8080/index.html")
+//	// ("/index.html", chomp.HostPortParts{Host: "2001:db8::1", Port: 8080}, nil)
+func HostPort() Combinator[HostPortParts] {
+	return func(s string) (string, HostPortParts, error) {
+		var rem, host string
+		var err error
+		if strings.HasPrefix(s, "[") {
+			rem, host, err = Delimited(Tag("["), Not("]"), Tag("]"))(s)
+		} else {
+			rem, host, err = While(isHostText{})(s)
+		}
+
+		if err != nil {
+			return s, HostPortParts{}, ParserError{Err: err, Type: "host_port"}
+		}
+
+		next, _, err := Tag(":")(rem)
+		if err != nil {
+			return rem, HostPortParts{Host: host}, nil
+		}
+
+		rem, port, err := While(IsDigit)(next)
+		if err != nil {
+			return s, HostPortParts{}, ParserError{Err: err, Type: "host_port"}
+		}
+
+		n, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return s, HostPortParts{}, ParserError{
+				Err:  CombinatorParseError{Input: port, Text: next, Type: "port"},
+				Type: "host_port",
+			}
+		}
+
+		return rem, HostPortParts{Host: host, Port: int(n)}, nil
+	}
+}
+
+// AddrPort will parse a literal IP address and port, ip:port. An IPv6 address
+// must be enclosed within brackets, [::1]:8080, while an IPv4 address must not.
+// Unlike [HostPort], both the IP address and port are required, with hostnames
+// being rejected.
+//
+//	chomp.AddrPort()("[2001:db8::1]:8080")
+//	// ("", netip.MustParseAddrPort("[2001:db8::1]:8080"), nil)
+func AddrPort() Combinator[netip.AddrPort] {
+	return func(s string) (string, netip.AddrPort, error) {
+		rem, hp, err := HostPort()(s)
+		if err != nil {
+			return s, netip.AddrPort{}, ParserError{Err: err, Type: "addr_port"}
+		}
+
+		bracketed := strings.HasPrefix(s, "[")
+		addr, err := netip.ParseAddr(hp.Host)
+		if err != nil || addr.Is4() == bracketed {
+			return s, netip.AddrPort{}, ParserError{
+				Err:  CombinatorParseError{Input: hp.Host, Text: s, Type: "ip_addr"},
+				Type: "addr_port",
+			}
+		}
+
+		// A missing port will only be consumed up to the host, which will either
+		// end with a closing bracket or not contain a port separator
+		consumed := s[:len(s)-len(rem)]
+		if strings.HasSuffix(consumed, "]") || (!bracketed && !strings.Contains(consumed, ":")) {
+			return s, netip.AddrPort{}, ParserError{
+				Err:  CombinatorParseError{Input: ":", Text: rem, Type: "port"},
+				Type: "addr_port",
+			}
+		}
+
+		return rem, netip.AddrPortFrom(addr, uint16(hp.Port)), nil
+	}
+}
+
+// IPv4 will parse an IPv4 address in dotted decimal notation, a.b.c.d, returning
+// the address as text. Each octet must be between 0 and 255. To avoid ambiguity
+// with octal notation, an octet cannot contain a leading zero.
+//
+//	chomp.IPv4()("192.168.0.1 - - [10/Oct/2023:13:55:36]")
+//	// (" - - [10/Oct/2023:13:55:36]", "192.168.0.1", nil)
+//
+// Use [MapErr] to convert the address into its individual octets:
+//
+//	chomp.MapErr(chomp.IPv4(), func(in string) ([4]byte, error) {
+//		addr, err := netip.ParseAddr(in)
+//		return addr.As4(), err
+//	})("192.168.0.1")
+//	// ("", [4]byte{192, 168, 0, 1}, nil)
+func IPv4() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem := s
+		for i := 0; i < 4; i++ {
+			var err error
+			if i > 0 {
+				if rem, _, err = Tag(".")(rem); err != nil {
+					return s, "", ParserError{Err: err, Type: "ipv4"}
+				}
+			}
+
+			if rem, err = ipv4Octet(rem); err != nil {
+				return s, "", ParserError{Err: err, Type: "ipv4"}
+			}
+		}
+
+		return rem, s[:len(s)-len(rem)], nil
+	}
+}
+
+func ipv4Octet(s string) (string, error) {
+	rem, octet, err := TakeWhileMN(1, 3, isASCIIDigit{}.Match)(s)
+	if err != nil {
+		return s, err
+	}
+
+	if n, _ := strconv.Atoi(octet); n > 255 || (len(octet) > 1 && octet[0] == '0') ||
+		(rem != "" && isASCIIDigit{}.Match(rune(rem[0]))) {
+		return s, CombinatorParseError{Input: octet, Text: s, Type: "ipv4_octet"}
+	}
+
+	return rem, nil
+}
+
+// IPv6 will parse an IPv6 address in its text form, returning the address as
+// text. Consecutive groups of zeros can be compressed using a double colon, ::,
+// but only once within an address. The final two groups can be replaced by an
+// embedded IPv4 address, such as ::ffff:192.0.2.1. Zones are not supported.
+//
+//	chomp.IPv6()("2001:db8::ff00:42:8329 port 22")
+//	// (" port 22", "2001:db8::ff00:42:8329", nil)
+//
+// Use [MapErr] to convert the address into a [netip.Addr]:
+//
+//	chomp.MapErr(chomp.IPv6(), netip.ParseAddr)("::1")
+//	// ("", netip.MustParseAddr("::1"), nil)
+func IPv6() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem := s
+		groups := 0
+		compressed := false
+
+		if strings.HasPrefix(rem, "::") {
+			rem = rem[2:]
+			compressed = true
+		}
+
+		for {
+			var ipv4Err error
+			if groups <= 6 {
+				var next string
+				if next, _, ipv4Err = IPv4()(rem); ipv4Err == nil {
+					rem = next
+					groups += 2
+					break
+				}
+			}
+
+			next, err := ipv6Hextet(rem)
+			if err != nil {
+				// An address can end with a double colon, such as 2001:db8::
+				if compressed && strings.HasSuffix(s[:len(s)-len(rem)], "::") &&
+					(rem == "" || !(isHexDigit{}).Match(rune(rem[0]))) {
+					break
+				}
+				return s, "", ParserError{Err: err, Type: "ipv6"}
+			}
+
+			// A hextet followed by a dot is an invalid embedded IPv4 address
+			if strings.HasPrefix(next, ".") && ipv4Err != nil {
+				return s, "", ParserError{Err: ipv4Err, Type: "ipv6"}
+			}
+			rem = next
+			groups++
+
+			if strings.HasPrefix(rem, "::") {
+				if compressed {
+					return s, "", ParserError{
+						Err:  CombinatorParseError{Input: "::", Text: rem, Type: "ipv6_compression"},
+						Type: "ipv6",
+					}
+				}
+				rem = rem[2:]
+				compressed = true
+				continue
+			}
+
+			if groups == 8 || !strings.HasPrefix(rem, ":") {
+				break
+			}
+			rem = rem[1:]
+		}
+
+		if (compressed && groups > 7) || (!compressed && groups != 8) {
+			return s, "", ParserError{
+				Err:  CombinatorParseError{Text: s, Type: "ipv6_groups"},
+				Type: "ipv6",
+			}
+		}
+
+		return rem, s[:len(s)-len(rem)], nil
+	}
+}
+
+func ipv6Hextet(s string) (string, error) {
+	rem, hextet, err := TakeWhileMN(1, 4, isHexDigit{}.Match)(s)
+	if err != nil {
+		return s, err
+	}
+
+	if rem != "" && (isHexDigit{}).Match(rune(rem[0])) {
+		return s, CombinatorParseError{Input: hextet, Text: s, Type: "ipv6_hextet"}
+	}
+
+	return rem, nil
+}
@@ -0,0 +1,636 @@
+package chomp_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostsLine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		entry chomp.HostsEntry
+	}{
+		{
+			name:  "IPv4",
+			input: "127.0.0.1\tlocalhost local # loopback\n::1 localhost",
+			rem:   "::1 localhost",
+			entry: chomp.HostsEntry{IP: netip.MustParseAddr("127.0.0.1"), Names: []string{"localhost", "local"}},
+		},
+		{
+			name:  "IPv6",
+			input: "  fe80::1%lo0 localhost",
+			rem:   "",
+			entry: chomp.HostsEntry{IP: netip.MustParseAddr("fe80::1%lo0"), Names: []string{"localhost"}},
+		},
+		{
+			name:  "CommentWithoutWhitespace",
+			input: "10.0.0.2 db.internal#primary\r\n",
+			rem:   "",
+			entry: chomp.HostsEntry{IP: netip.MustParseAddr("10.0.0.2"), Names: []string{"db.internal"}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, entry, err := chomp.HostsLine()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.entry, entry)
+		})
+	}
+}
+
+func TestHostsLineInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "MalformedAddress",
+			input: "127.0.0.256 localhost",
+			err:   `(hosts_line) parser failed. ParseAddr("127.0.0.256"): IPv4 field has value >255`,
+		},
+		{
+			name:  "MissingHostname",
+			input: "127.0.0.1 # loopback",
+			err:   "(hosts_line) parser failed. (many_n) parser failed [count: 0 min: 1]. (while_n) parser failed [count: 0 min: 1]. (is_hosts_text) combinator failed to parse text '# loopback'",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.HostsLine()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestHostsFile(t *testing.T) {
+	t.Parallel()
+
+	input := `# The following lines are desirable for IPv4 capable hosts
+127.0.0.1       localhost
+
+   # IPv6
+::1             localhost ip6-localhost ip6-loopback
+192.168.1.10    nas.local
+`
+
+	rem, entries, err := chomp.HostsFile()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, []chomp.HostsEntry{
+		{IP: netip.MustParseAddr("127.0.0.1"), Names: []string{"localhost"}},
+		{IP: netip.MustParseAddr("::1"), Names: []string{"localhost", "ip6-localhost", "ip6-loopback"}},
+		{IP: netip.MustParseAddr("192.168.1.10"), Names: []string{"nas.local"}},
+	}, entries)
+}
+
+func TestResolvConf(t *testing.T) {
+	t.Parallel()
+
+	input := `# Generated by NetworkManager
+domain example.com
+search example.com corp.example.com
+nameserver 1.1.1.1
+nameserver 2606:4700:4700::1111 ; cloudflare
+options ndots:2 timeout:1
+options rotate
+
+`
+
+	rem, conf, err := chomp.ResolvConf()(input)
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, map[string][]string{
+		"domain":     {"example.com"},
+		"search":     {"example.com", "corp.example.com"},
+		"nameserver": {"1.1.1.1", "2606:4700:4700::1111"},
+		"options":    {"ndots:2", "timeout:1", "rotate"},
+	}, conf)
+}
+
+func TestResolvConfInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "MalformedNameserver",
+			input: "nameserver dns.google\n",
+		},
+		{
+			name:  "MissingValue",
+			input: "search\n",
+		},
+		{
+			name:  "MissingOptionValue",
+			input: "options ndots:\n",
+		},
+		{
+			name:  "MultipleOptionValues",
+			input: "options ndots:1:2\n",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.ResolvConf()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestHostPort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		rem      string
+		expected chomp.HostPortParts
+	}{
+		{
+			name:     "Hostname",
+			input:    "example.com:8080/index.html",
+			rem:      "/index.html",
+			expected: chomp.HostPortParts{Host: "example.com", Port: 8080},
+		},
+		{
+			name:     "HostnameOnly",
+			input:    "localhost/index.html",
+			rem:      "/index.html",
+			expected: chomp.HostPortParts{Host: "localhost"},
+		},
+		{
+			name:     "IPv4",
+			input:    "192.168.0.1:443",
+			rem:      "",
+			expected: chomp.HostPortParts{Host: "192.168.0.1", Port: 443},
+		},
+		{
+			name:     "IPv6",
+			input:    "[2001:db8::1]:8080 ",
+			rem:      " ",
+			expected: chomp.HostPortParts{Host: "2001:db8::1", Port: 8080},
+		},
+		{
+			name:     "IPv6Only",
+			input:    "[::1]",
+			rem:      "",
+			expected: chomp.HostPortParts{Host: "::1"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, hp, err := chomp.HostPort()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.expected, hp)
+		})
+	}
+}
+
+func TestHostPortInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "MissingHost",
+			input: ":8080",
+			err:   "(is_host_text) combinator failed",
+		},
+		{
+			name:  "MissingPort",
+			input: "example.com:",
+			err:   "(is_digit) combinator failed",
+		},
+		{
+			name:  "PortOutOfRange",
+			input: "example.com:65536",
+			err:   "(port) combinator failed to parse text '65536' with input '65536'",
+		},
+		{
+			name:  "UnterminatedIPv6",
+			input: "[::1:8080",
+			err:   "(delimited) parser failed",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.HostPort()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(host_port) parser failed")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestAddrPort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected netip.AddrPort
+	}{
+		{
+			name:     "IPv4",
+			input:    "192.168.0.1:443",
+			expected: netip.MustParseAddrPort("192.168.0.1:443"),
+		},
+		{
+			name:     "IPv6",
+			input:    "[2001:db8::1]:8080",
+			expected: netip.MustParseAddrPort("[2001:db8::1]:8080"),
+		},
+		{
+			name:     "IPv6Zone",
+			input:    "[fe80::1%eth0]:22",
+			expected: netip.MustParseAddrPort("[fe80::1%eth0]:22"),
+		},
+		{
+			name:     "AnyPort",
+			input:    "0.0.0.0:0",
+			expected: netip.MustParseAddrPort("0.0.0.0:0"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ap, err := chomp.AddrPort()(tt.input + " accept")
+
+			require.NoError(t, err)
+			assert.Equal(t, " accept", rem)
+			assert.Equal(t, tt.expected, ap)
+		})
+	}
+}
+
+func TestAddrPortInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "Hostname",
+			input: "localhost:8080",
+			err:   "(ip_addr) combinator failed to parse text 'localhost:8080' with input 'localhost'",
+		},
+		{
+			name:  "InvalidIPv4",
+			input: "192.168.0.256:80",
+			err:   "(ip_addr) combinator failed",
+		},
+		{
+			name:  "BracketedIPv4",
+			input: "[192.168.0.1]:80",
+			err:   "(ip_addr) combinator failed",
+		},
+		{
+			name:  "MissingPort",
+			input: "192.168.0.1",
+			err:   "(port) combinator failed to parse text '' with input ':'",
+		},
+		{
+			name:  "MissingIPv6Port",
+			input: "[::1] accept",
+			err:   "(port) combinator failed to parse text ' accept' with input ':'",
+		},
+		{
+			name:  "InvalidPort",
+			input: "[::1]:65536",
+			err:   "(port) combinator failed to parse text '65536' with input '65536'",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.AddrPort()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(addr_port) parser failed")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestIPv4(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Private",
+			input: "192.168.0.1 - - [10/Oct/2023:13:55:36]",
+			rem:   " - - [10/Oct/2023:13:55:36]",
+			ext:   "192.168.0.1",
+		},
+		{
+			name:  "Boundaries",
+			input: "0.9.99.255",
+			rem:   "",
+			ext:   "0.9.99.255",
+		},
+		{
+			name:  "WithPort",
+			input: "10.0.0.1:8080",
+			rem:   ":8080",
+			ext:   "10.0.0.1",
+		},
+		{
+			name:  "EndOfSentence",
+			input: "127.0.0.1.",
+			rem:   ".",
+			ext:   "127.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.IPv4()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestIPv4Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "OctetOutOfRange",
+			input: "192.168.0.256",
+			err:   "(ipv4_octet) combinator failed to parse text '256' with input '256'",
+		},
+		{
+			name:  "TooManyDigits",
+			input: "192.1680.0.1",
+			err:   "(ipv4_octet) combinator failed to parse text '1680.0.1' with input '168'",
+		},
+		{
+			name:  "LeadingZero",
+			input: "192.168.01.1",
+			err:   "(ipv4_octet) combinator failed to parse text '01.1' with input '01'",
+		},
+		{
+			name:  "TooFewOctets",
+			input: "192.168.0",
+			err:   "(tag) combinator failed to parse text '' with input '.'",
+		},
+		{
+			name:  "Hostname",
+			input: "localhost",
+			err:   "(take_while_m_n) parser failed",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.IPv4()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(ipv4) parser failed")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestIPv4MapErr(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.MapErr(chomp.IPv4(), func(in string) ([4]byte, error) {
+		addr, err := netip.ParseAddr(in)
+		return addr.As4(), err
+	})("192.168.0.1")
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, [4]byte{192, 168, 0, 1}, ext)
+}
+
+func TestIPv6(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Full",
+			input: "2001:0db8:85a3:0000:0000:8a2e:0370:7334",
+			rem:   "",
+			ext:   "2001:0db8:85a3:0000:0000:8a2e:0370:7334",
+		},
+		{
+			name:  "Compressed",
+			input: "2001:db8::ff00:42:8329 port 22",
+			rem:   " port 22",
+			ext:   "2001:db8::ff00:42:8329",
+		},
+		{
+			name:  "Loopback",
+			input: "::1",
+			rem:   "",
+			ext:   "::1",
+		},
+		{
+			name:  "Unspecified",
+			input: ":: ",
+			rem:   " ",
+			ext:   "::",
+		},
+		{
+			name:  "TrailingCompression",
+			input: "fe80::/10",
+			rem:   "/10",
+			ext:   "fe80::",
+		},
+		{
+			name:  "CompressSingleGroup",
+			input: "1:2:3:4:5:6::8",
+			rem:   "",
+			ext:   "1:2:3:4:5:6::8",
+		},
+		{
+			name:  "EmbeddedIPv4",
+			input: "::ffff:192.0.2.1]",
+			rem:   "]",
+			ext:   "::ffff:192.0.2.1",
+		},
+		{
+			name:  "FullEmbeddedIPv4",
+			input: "64:ff9b:0:0:0:0:192.0.2.33",
+			rem:   "",
+			ext:   "64:ff9b:0:0:0:0:192.0.2.33",
+		},
+		{
+			name:  "MixedCase",
+			input: "FE80::ABCD:ef01",
+			rem:   "",
+			ext:   "FE80::ABCD:ef01",
+		},
+		{
+			name:  "StopsAfterEightGroups",
+			input: "1:2:3:4:5:6:7:8:9",
+			rem:   ":9",
+			ext:   "1:2:3:4:5:6:7:8",
+		},
+		{
+			name:  "StopsAfterEmbeddedIPv4",
+			input: "::192.0.2.1:1",
+			rem:   ":1",
+			ext:   "::192.0.2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.IPv6()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+
+			_, err = netip.ParseAddr(ext)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestIPv6Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "DoubleCompression",
+			input: "2001::db8::1",
+			err:   "(ipv6_compression) combinator failed to parse text '::1' with input '::'",
+		},
+		{
+			name:  "LeadingDoubleCompression",
+			input: "::1::",
+			err:   "(ipv6_compression) combinator failed",
+		},
+		{
+			name:  "TooFewGroups",
+			input: "1:2:3:4:5:6:7",
+			err:   "(ipv6_groups) combinator failed",
+		},
+		{
+			name:  "TooManyCompressedGroups",
+			input: "1:2:3:4::5:6:7:8",
+			err:   "(ipv6_groups) combinator failed",
+		},
+		{
+			name:  "HextetTooLong",
+			input: "2001:db8::12345",
+			err:   "(ipv6_hextet) combinator failed to parse text '12345' with input '1234'",
+		},
+		{
+			name:  "DanglingColon",
+			input: "2001:db8:",
+			err:   "(take_while_m_n) parser failed",
+		},
+		{
+			name:  "LeadingSingleColon",
+			input: ":1:2:3:4:5:6:7",
+			err:   "(take_while_m_n) parser failed",
+		},
+		{
+			name:  "InvalidEmbeddedIPv4",
+			input: "::ffff:192.0.2.256",
+			err:   "(ipv4) parser failed. (ipv4_octet) combinator failed",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.IPv6()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(ipv6) parser failed")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
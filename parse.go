@@ -0,0 +1,136 @@
+package chomp
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ParseOption configures how [Parse] applies a [Combinator] to the input text.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	allowTrailing bool
+}
+
+// AllowTrailing permits [Parse] to succeed without the [Combinator] consuming
+// the entire input text. Any unconsumed (trailing) text is discarded.
+func AllowTrailing() ParseOption {
+	return func(opts *parseOptions) {
+		opts.allowTrailing = true
+	}
+}
+
+// Parse will apply the [Combinator] to the input text and return its parsed
+// value. By default, the [Combinator] must consume the entire input text,
+// otherwise an error containing the unconsumed (trailing) text is returned.
+// Upon failure, a [ParseError] is returned identifying the line and column
+// within the input text where parsing failed. It is the recommended way of
+// invoking a top-level parser.
+//
+//	chomp.Parse(chomp.Tag("Hello"), "Hello")
+//	// ("Hello", nil)
+//
+//	chomp.Parse(chomp.Tag("Hello"), "Hello, World!")
+//	// ("", "(parse) parser failed at 1:6. (trailing_input) combinator failed to parse text ', World!'")
+//
+//	chomp.Parse(chomp.Tag("Hello"), "Hello, World!", chomp.AllowTrailing())
+//	// ("Hello", nil)
+func Parse[T any](c Combinator[T], input string, opts ...ParseOption) (T, error) {
+	var cfg parseOptions
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var out T
+
+	rem, ext, err := c(input)
+	if err != nil {
+		return out, ParseError{Err: err, Pos: NewSource(input).Position(failedAt(input, rem, err))}
+	}
+
+	if rem != "" && !cfg.allowTrailing {
+		return out, ParseError{
+			Err: CombinatorParseError{Text: rem, Type: "trailing_input"},
+			Pos: NewSource(input).Position(len(input) - len(rem)),
+		}
+	}
+
+	return ext, nil
+}
+
+// failedAt returns the furthest byte offset within the input text reached by
+// a failed combinator. As combinators only ever consume from the front of the
+// text, any remaining text within an error identifies how far it got.
+func failedAt(input, rem string, err error) int {
+	offset := 0
+	if strings.HasSuffix(input, rem) {
+		offset = len(input) - len(rem)
+	}
+
+	if cpe, ok := err.(CombinatorParseError); ok && strings.HasSuffix(input, cpe.Text) {
+		offset = len(input) - len(cpe.Text)
+	}
+
+	var inner []error
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		inner = append(inner, e.Unwrap())
+	case interface{ Unwrap() []error }:
+		inner = e.Unwrap()
+	}
+
+	for _, ie := range inner {
+		if at := failedAt(input, rem, ie); at > offset {
+			offset = at
+		}
+	}
+
+	return offset
+}
+
+// MustParse is like [Parse] but panics if the input text cannot be parsed.
+// It simplifies the parsing of known-good input, such as within tests or
+// when initializing package-level variables.
+//
+//	chomp.MustParse(chomp.Tag("Hello"), "Hello")
+//	// "Hello"
+func MustParse[T any](c Combinator[T], input string, opts ...ParseOption) T {
+	ext, err := Parse(c, input, opts...)
+	if err != nil {
+		panic(`chomp: MustParse(` + quote(input) + `): ` + err.Error())
+	}
+
+	return ext
+}
+
+// AllConsuming will apply the [Combinator] and ensure that it consumes the
+// entire input text. If any text remains, a [CombinatorParseError] is returned
+// with its Text set to the unconsumed remainder. Unlike [Parse], it is itself
+// a [Combinator] and can be composed with other combinators.
+//
+//	chomp.AllConsuming(chomp.Tag("Hello"))("Hello, World!")
+//	// ("Hello, World!", "", "(all_consuming) combinator failed to parse text ', World!'")
+func AllConsuming[T any](c Combinator[T]) Combinator[T] {
+	return func(s string) (string, T, error) {
+		var out T
+
+		rem, ext, err := c(s)
+		if err != nil {
+			return s, out, ParserError{Err: err, Type: "all_consuming"}
+		}
+
+		if rem != "" {
+			return s, out, CombinatorParseError{Text: rem, Type: "all_consuming"}
+		}
+
+		return rem, ext, nil
+	}
+}
+
+func quote(s string) string {
+	if len(s) > truncateErrAt {
+		s = s[:truncateErrAt] + "...(truncated)"
+	}
+
+	return strconv.Quote(s)
+}
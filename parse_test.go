@@ -0,0 +1,147 @@
+package chomp_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	ext, err := chomp.Parse(chomp.SepPair(chomp.Until(","), chomp.Tag(", "), chomp.Until("!")), "Hello, World!")
+
+	require.Error(t, err)
+	assert.Nil(t, ext)
+	assert.EqualError(t, err, "(parse) parser failed at 1:13. (trailing_input) combinator failed to parse text '!'")
+
+	ext, err = chomp.Parse(chomp.SepPair(chomp.Until(","), chomp.Tag(", "), chomp.Until("!")), "Hello, World")
+	require.Error(t, err)
+	assert.Nil(t, ext)
+}
+
+func TestParseConsumesAll(t *testing.T) {
+	t.Parallel()
+
+	ext, err := chomp.Parse(chomp.Tag("こんにちは"), "こんにちは")
+
+	require.NoError(t, err)
+	assert.Equal(t, "こんにちは", ext)
+}
+
+func TestParseAllowTrailing(t *testing.T) {
+	t.Parallel()
+
+	ext, err := chomp.Parse(chomp.Tag("Hello"), "Hello, World!", chomp.AllowTrailing())
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", ext)
+}
+
+func TestParseErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		c     chomp.Combinator[[]string]
+		input string
+		pos   chomp.Position
+	}{
+		{
+			name:  "TrailingInput",
+			c:     chomp.ManyN(chomp.Suffixed(chomp.Until("="), chomp.Tag("=\n")), 1),
+			input: "key=\nvalue=\n!",
+			pos:   chomp.Position{Offset: 12, Line: 3, Column: 1},
+		},
+		{
+			name: "FurthestFailure",
+			c: chomp.All(
+				chomp.Suffixed(chomp.Tag("[core]"), chomp.Crlf()),
+				chomp.Suffixed(chomp.Tag("こんにちは"), chomp.Tag("=")),
+				chomp.Tag("true")),
+			input: "[core]\nこんにちは=false",
+			pos:   chomp.Position{Offset: 23, Line: 2, Column: 7},
+		},
+		{
+			name: "FurthestAltBranch",
+			c: chomp.Alt(
+				chomp.Pair(chomp.Tag("let "), chomp.Tag("x")),
+				chomp.Pair(chomp.Tag("let"), chomp.Tag("ter"))),
+			input: "let y",
+			pos:   chomp.Position{Offset: 4, Line: 1, Column: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := chomp.Parse(tt.c, tt.input)
+
+			var parseErr chomp.ParseError
+			require.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, tt.pos, parseErr.Pos)
+		})
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"Hello", "World"},
+		chomp.MustParse(chomp.SepPair(chomp.Until(","), chomp.Tag(", "), chomp.Tag("World")), "Hello, World"))
+}
+
+func TestMustParsePanics(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithValue(t,
+		`chomp: MustParse("Hello, World!"): (parse) parser failed at 1:6. (trailing_input) combinator failed to parse text ', World!'`,
+		func() { chomp.MustParse(chomp.Tag("Hello"), "Hello, World!") })
+}
+
+func TestAllConsuming(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.AllConsuming(chomp.Tag("Hello"))("Hello")
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, "Hello", ext)
+}
+
+func TestAllConsumingTrailingInput(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.AllConsuming(chomp.Tag("Hello"))("Hello, World!")
+
+	require.EqualError(t, err, "(all_consuming) combinator failed to parse text ', World!'")
+	assert.Equal(t, "Hello, World!", rem)
+	assert.Empty(t, ext)
+
+	var parseErr chomp.CombinatorParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, ", World!", parseErr.Text)
+}
+
+func TestAllConsumingTruncatesTrailingInput(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.AllConsuming(chomp.Tag("Hello"))("Hello" + strings.Repeat("!", 100))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "...(truncated)")
+}
+
+func TestAllConsumingInnerError(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.AllConsuming(chomp.Tag("Hello"))("Goodbye")
+
+	require.EqualError(t, err, "(all_consuming) parser failed. (tag) combinator failed to parse text 'Goodbye' with input 'Hello'")
+	assert.Equal(t, "Goodbye", rem)
+}
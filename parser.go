@@ -1,22 +1,53 @@
 package chomp
 
-import "strings"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
-// Crlf must match either a CR '\r' or CRLF '\r\n' line ending.
+// Crlf must match either a LF '\n' or CRLF '\r\n' line ending at the
+// start of the input text. A lone CR '\r' is rejected.
 //
 //	chomp.Crlf()("\r\nHello")
 //	// ("Hello", "\r\n", nil)
 func Crlf() Combinator[string] {
 	return func(s string) (string, string, error) {
-		idx := strings.Index(s, "\n")
-		if idx == 0 || (idx == 1 && s[0] == '\r') {
-			return s[idx+1:], s[:idx+1], nil
+		switch {
+		case strings.HasPrefix(s, "\n"):
+			return s[1:], s[:1], nil
+		case strings.HasPrefix(s, "\r\n"):
+			return s[2:], s[:2], nil
 		}
 
 		return s, "", CombinatorParseError{Text: s, Type: "crlf"}
 	}
 }
 
+// Newline must match a single LF '\n' line ending. Both a CRLF '\r\n'
+// line ending and a lone CR '\r' are rejected.
+//
+//	chomp.Newline()("\nHello")
+//	// ("Hello", "\n", nil)
+func Newline() Combinator[string] {
+	return func(s string) (string, string, error) {
+		if strings.HasPrefix(s, "\n") {
+			return s[1:], s[:1], nil
+		}
+
+		return s, "", CombinatorParseError{Input: "\n", Text: s, Type: "newline"}
+	}
+}
+
+// LineEnding must match either a LF '\n' or CRLF '\r\n' line ending. A lone
+// CR '\r' is rejected. It is an alias of [Crlf] that reads more naturally
+// alongside [Newline].
+//
+//	chomp.LineEnding()("\r\nHello")
+//	// ("Hello", "\r\n", nil)
+func LineEnding() Combinator[string] {
+	return Crlf()
+}
+
 // Eol will scan and return any text before any ASCII line ending
 // characters. Line endings are discarded.
 //
@@ -27,3 +58,159 @@ func Eol() Combinator[string] {
 		return Suffixed(WhileNotN(IsLineEnding, 0), Opt(Crlf()))(s)
 	}
 }
+
+// Spaces must match one or more whitespace characters that do not break a line,
+// as defined by [IsSpace]. Line endings are not consumed, ensuring line-oriented
+// grammars can still detect the end of a line. Use [Multispace] to also consume
+// any line endings.
+//
+//	chomp.Spaces()(" \t Hello,\nWorld!")
+//	// ("Hello,\nWorld!", " \t ", nil)
+func Spaces() Combinator[string] {
+	return WhileN(IsSpace, 1)
+}
+
+// Spaces0 will match zero or more whitespace characters that do not break a line,
+// as defined by [IsSpace]. It will never return an error.
+//
+//	chomp.Spaces0()("Hello, World!")
+//	// ("Hello, World!", "", nil)
+func Spaces0() Combinator[string] {
+	return WhileN(IsSpace, 0)
+}
+
+// Multispace must match one or more whitespace characters, including line
+// endings, as defined by [IsMultispace].
+//
+//	chomp.Multispace()(" \r\n\tHello, World!")
+//	// ("Hello, World!", " \r\n\t", nil)
+func Multispace() Combinator[string] {
+	return WhileN(IsMultispace, 1)
+}
+
+// Multispace0 will match zero or more whitespace characters, including line
+// endings, as defined by [IsMultispace]. It will never return an error.
+//
+//	chomp.Multispace0()("Hello, World!")
+//	// ("Hello, World!", "", nil)
+func Multispace0() Combinator[string] {
+	return WhileN(IsMultispace, 0)
+}
+
+// Words will split the input text into words separated by any amount of
+// whitespace, including line endings, as defined by [IsMultispace]. Any
+// leading or trailing whitespace is discarded. The entire input text is
+// consumed, and at least one word must exist.
+//
+//	chomp.Words()("  Hello and\u00a0Good\tMorning!\n")
+//	// ("", []string{"Hello", "and", "Good", "Morning!"}, nil)
+func Words() Combinator[[]string] {
+	return func(s string) (string, []string, error) {
+		rem, _, _ := Multispace0()(s)
+
+		rem, words, err := Many(Terminated(WhileNot(IsMultispace), Multispace0()))(rem)
+		if err != nil {
+			return s, nil, ParserError{Err: err, Type: "words"}
+		}
+
+		return rem, words, nil
+	}
+}
+
+// Identifier must match an identifier, as commonly used by programming languages,
+// at the beginning of the input text. An identifier must start with a letter, as
+// defined by [IsLetter], or an underscore '_', followed by zero or more letters,
+// digits or underscores.
+//
+//	chomp.Identifier()("_count2 := 0")
+//	// (" := 0", "_count2", nil)
+func Identifier() Combinator[string] {
+	return func(s string) (string, string, error) {
+		pos := 0
+		for pos < len(s) {
+			r, size := utf8.DecodeRuneInString(s[pos:])
+			if !(r == '_' || IsLetter.Match(r) || (pos > 0 && IsDigit.Match(r))) {
+				break
+			}
+			pos += size
+		}
+
+		if pos == 0 {
+			return s, "", CombinatorParseError{Text: s, Type: "identifier"}
+		}
+
+		return s[pos:], s[:pos], nil
+	}
+}
+
+// QuotedIdentifier must match a delimited identifier, as used by SQL, at the
+// beginning of the input text. The identifier is enclosed within the provided
+// quote, with any quote inside of the identifier escaped by doubling it. The
+// unescaped identifier is returned without its enclosing quotes. An empty
+// identifier is rejected.
+//
+//	chomp.QuotedIdentifier('"')(`"My ""Big"" Table".id`)
+//	// (".id", `My "Big" Table`, nil)
+func QuotedIdentifier(quote rune) Combinator[string] {
+	q := string(quote)
+
+	return func(s string) (string, string, error) {
+		if !strings.HasPrefix(s, q) {
+			return s, "", CombinatorParseError{Input: q, Text: s, Type: "quoted_identifier"}
+		}
+
+		var buf strings.Builder
+		rem := s[len(q):]
+		for {
+			idx := strings.Index(rem, q)
+			if idx == -1 {
+				return s, "", CombinatorParseError{Input: q, Text: s, Type: "quoted_identifier"}
+			}
+			buf.WriteString(rem[:idx])
+			rem = rem[idx+len(q):]
+
+			if !strings.HasPrefix(rem, q) {
+				break
+			}
+			buf.WriteString(q)
+			rem = rem[len(q):]
+		}
+
+		if buf.Len() == 0 {
+			return s, "", CombinatorParseError{Input: q, Text: s, Type: "quoted_identifier"}
+		}
+
+		return rem, buf.String(), nil
+	}
+}
+
+// DottedPath must match a path of one or more elements, each separated by a
+// dot '.', such as schema.table.column. Any dot not followed by an element
+// is not consumed.
+//
+//	chomp.DottedPath(chomp.Identifier())("public.users.id = 1")
+//	// (" = 1", []string{"public", "users", "id"}, nil)
+func DottedPath(element Combinator[string]) Combinator[[]string] {
+	return func(s string) (string, []string, error) {
+		rem, ext, err := element(s)
+		if err != nil {
+			return s, nil, ParserError{Err: err, Type: "dotted_path"}
+		}
+		path := []string{ext}
+
+		for {
+			next, _, err := Tag(".")(rem)
+			if err != nil {
+				break
+			}
+
+			if next, ext, err = element(next); err != nil {
+				break
+			}
+			path = append(path, ext)
+			rem = next
+		}
+
+		return rem, path, nil
+	}
+}
@@ -1,6 +1,7 @@
 package chomp_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/purpleclay/chomp"
@@ -29,6 +30,12 @@ func TestCrlf(t *testing.T) {
 			rem:   "こんにちは",
 			ext:   "\r\n",
 		},
+		{
+			name:  "CRLFOnly",
+			input: "\r\n",
+			rem:   "",
+			ext:   "\r\n",
+		},
 		{
 			name:  "LFOnly",
 			input: "\n",
@@ -49,6 +56,43 @@ func TestCrlf(t *testing.T) {
 	}
 }
 
+func TestCrlfRejectsLoneCarriageReturn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "CR",
+			input: "\r",
+		},
+		{
+			name:  "CRFollowedByText",
+			input: "\rabc",
+		},
+		{
+			name:  "CRLFNotAtStart",
+			input: "a\r\nb",
+		},
+		{
+			name:  "LFNotAtStart",
+			input: "ab\n",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.Crlf()(tt.input)
+
+			require.EqualError(t, err, fmt.Sprintf("(crlf) combinator failed to parse text '%s'", tt.input))
+			assert.Equal(t, tt.input, rem)
+			assert.Empty(t, ext)
+		})
+	}
+}
+
 func TestEol(t *testing.T) {
 	t.Parallel()
 
@@ -91,3 +135,407 @@ It's a great day`,
 		})
 	}
 }
+
+func TestSpaces(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Spaces()(" \t\u00a0Hello,\nWorld!")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello,\nWorld!", rem)
+	assert.Equal(t, " \t\u00a0", ext)
+}
+
+func TestSpacesStopsAtLineEnding(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Spaces()("  \r\nHello")
+
+	require.NoError(t, err)
+	assert.Equal(t, "\r\nHello", rem)
+	assert.Equal(t, "  ", ext)
+}
+
+func TestSpacesNoMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.Spaces()("\nHello")
+
+	require.Error(t, err)
+	assert.Equal(t, "\nHello", rem)
+}
+
+func TestSpaces0(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Spaces0()("Hello, World!")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello, World!", rem)
+	assert.Empty(t, ext)
+}
+
+func TestMultispace(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Multispace()(" \r\n\t\u2028Hello, World!")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello, World!", rem)
+	assert.Equal(t, " \r\n\t\u2028", ext)
+}
+
+func TestMultispaceNoMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.Multispace()("Hello, World!")
+
+	require.Error(t, err)
+	assert.Equal(t, "Hello, World!", rem)
+}
+
+func TestMultispace0(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Multispace0()("Hello, World!")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello, World!", rem)
+	assert.Empty(t, ext)
+}
+
+func TestNewline(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Newline()("\nHello")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", rem)
+	assert.Equal(t, "\n", ext)
+}
+
+func TestNewlineRejectsCarriageReturn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "CRLF",
+			input: "\r\nHello",
+		},
+		{
+			name:  "CR",
+			input: "\rHello",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.Newline()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestLineEnding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "LF",
+			input: "\nHello",
+			rem:   "Hello",
+			ext:   "\n",
+		},
+		{
+			name:  "CRLF",
+			input: "\r\nこんにちは",
+			rem:   "こんにちは",
+			ext:   "\r\n",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.LineEnding()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestLineEndingRejectsLoneCarriageReturn(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.LineEnding()("\rHello")
+
+	require.Error(t, err)
+	assert.Equal(t, "\rHello", rem)
+}
+
+func TestWords(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		words []string
+	}{
+		{
+			name:  "SingleWord",
+			input: "Hello",
+			words: []string{"Hello"},
+		},
+		{
+			name:  "FinalWordKept",
+			input: "Hello and Good Morning!",
+			words: []string{"Hello", "and", "Good", "Morning!"},
+		},
+		{
+			name:  "LeadingAndTrailingWhitespace",
+			input: "  \tHello,   World!\r\n",
+			words: []string{"Hello,", "World!"},
+		},
+		{
+			name:  "UnicodeWhitespace",
+			input: "こんにちは\u00a0世界\u2003!",
+			words: []string{"こんにちは", "世界", "!"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, words, err := chomp.Words()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, "", rem)
+			assert.Equal(t, tt.words, words)
+		})
+	}
+}
+
+func TestWordsNoMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.Words()(" \t\n")
+
+	require.Error(t, err)
+	assert.Equal(t, " \t\n", rem)
+	assert.ErrorContains(t, err, "(words) parser failed")
+}
+
+func TestIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Ascii",
+			input: "count2 := 0",
+			rem:   " := 0",
+			ext:   "count2",
+		},
+		{
+			name:  "LeadingUnderscore",
+			input: "__init__()",
+			rem:   "()",
+			ext:   "__init__",
+		},
+		{
+			name:  "Unicode",
+			input: "größe_1 = 10",
+			rem:   " = 10",
+			ext:   "größe_1",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.Identifier()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestIdentifierLeadingDigit(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.Identifier()("2count")
+
+	assert.Equal(t, "2count", rem)
+	assert.EqualError(t, err, "(identifier) combinator failed to parse text '2count'")
+}
+
+func TestQuotedIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		quote rune
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "DoubleQuote",
+			quote: '"',
+			input: `"order items".id`,
+			rem:   ".id",
+			ext:   "order items",
+		},
+		{
+			name:  "DoubledQuoteEscape",
+			quote: '"',
+			input: `"My ""Big"" Table"`,
+			rem:   "",
+			ext:   `My "Big" Table`,
+		},
+		{
+			name:  "OnlyEscapedQuote",
+			quote: '"',
+			input: `"""" AS q`,
+			rem:   " AS q",
+			ext:   `"`,
+		},
+		{
+			name:  "Backtick",
+			quote: '`',
+			input: "`select``s` FROM t",
+			rem:   " FROM t",
+			ext:   "select`s",
+		},
+		{
+			name:  "Unicode",
+			quote: '｢',
+			input: "｢こんにちは｢｢｢",
+			rem:   "",
+			ext:   "こんにちは｢",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.QuotedIdentifier(tt.quote)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestQuotedIdentifierInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Unquoted",
+			input: "users",
+		},
+		{
+			name:  "Unterminated",
+			input: `"users`,
+		},
+		{
+			name:  "UnterminatedAfterEscape",
+			input: `"users""`,
+		},
+		{
+			name:  "Empty",
+			input: `""`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.QuotedIdentifier('"')(tt.input)
+
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(quoted_identifier) combinator failed")
+		})
+	}
+}
+
+func TestDottedPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   []string
+	}{
+		{
+			name:  "Single",
+			input: "users WHERE",
+			rem:   " WHERE",
+			ext:   []string{"users"},
+		},
+		{
+			name:  "Multiple",
+			input: `public."order items".id = 1`,
+			rem:   " = 1",
+			ext:   []string{"public", "order items", "id"},
+		},
+		{
+			name:  "TrailingDot",
+			input: "public.users.",
+			rem:   ".",
+			ext:   []string{"public", "users"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.DottedPath(
+				chomp.First(chomp.QuotedIdentifier('"'), chomp.Identifier()))(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestDottedPathInvalid(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.DottedPath(chomp.Identifier())(".users")
+
+	assert.Equal(t, ".users", rem)
+	assert.EqualError(t, err, "(dotted_path) parser failed. (identifier) combinator failed to parse text '.users'")
+}
@@ -0,0 +1,110 @@
+package chomp
+
+import (
+	"strings"
+	"unicode"
+)
+
+// PathStyle defines the semantics used when parsing a file path.
+type PathStyle int
+
+const (
+	// PathPOSIX only recognizes a forward slash '/' as a path separator.
+	PathPOSIX PathStyle = iota
+
+	// PathWindows recognizes both a backslash '\' and forward slash '/'
+	// as a path separator. Drive letters (C:) and UNC prefixes
+	// (\\server\share) are also supported.
+	PathWindows
+)
+
+// FilePathParts contains the individual parts of a parsed file path.
+type FilePathParts struct {
+	// Volume contains either a drive letter (C:) or UNC prefix
+	// (\\server\share). Only set when parsing a Windows path.
+	Volume string
+
+	// Components contains each segment of the path in order. Special
+	// segments, '.' and '..', are retained as is.
+	Components []string
+
+	// Absolute is true if the path is rooted.
+	Absolute bool
+}
+
+type isPathText struct{}
+
+func (isPathText) Match(r rune) bool {
+	return !unicode.IsSpace(r)
+}
+
+func (isPathText) String() string {
+	return "is_path_text"
+}
+
+// FilePath will parse a file path into its individual components. The path
+// will be parsed until the first whitespace character. Repeated separators
+// are treated as a single separator. The [PathStyle] determines which
+// separators are recognized and whether Windows volumes are supported.
+//
+//	chomp.FilePath(chomp.PathWindows)(`C:\Users\..\purpleclay\go.mod is a file`)
+//	// (" is a file", chomp.FilePathParts{Volume: "C:", Components: []string{"Users", "..", "purpleclay", "go.mod"}, Absolute: true}, nil)
+func FilePath(style PathStyle) Combinator[FilePathParts] {
+	return Combinator[FilePathParts](Map(filePath(style), func(in []string) FilePathParts {
+		return FilePathParts{Volume: in[0], Absolute: in[1] != "", Components: in[2:]}
+	}))
+}
+
+func filePath(style PathStyle) Combinator[[]string] {
+	seps := "/"
+	if style == PathWindows {
+		seps = `\/`
+	}
+
+	isSep := func(r rune) bool { return strings.ContainsRune(seps, r) }
+
+	return func(s string) (string, []string, error) {
+		rem, text, err := While(isPathText{})(s)
+		if err != nil {
+			return s, nil, CombinatorParseError{Text: s, Type: "file_path"}
+		}
+
+		var volume, root string
+		if style == PathWindows {
+			if volume, text, err = windowsVolume(text, seps); err != nil {
+				return s, nil, CombinatorParseError{Text: s, Type: "file_path"}
+			}
+
+			if strings.HasPrefix(volume, `\\`) || strings.HasPrefix(volume, "//") {
+				root = volume[:1]
+			}
+		}
+
+		if text != "" && isSep(rune(text[0])) {
+			root = text[:1]
+		}
+
+		return rem, append([]string{volume, root}, strings.FieldsFunc(text, isSep)...), nil
+	}
+}
+
+func windowsVolume(text, seps string) (string, string, error) {
+	if len(text) >= 2 && text[1] == ':' && unicode.IsLetter(rune(text[0])) {
+		return text[:2], text[2:], nil
+	}
+
+	if len(text) < 2 || !strings.ContainsRune(seps, rune(text[0])) || text[0] != text[1] {
+		return "", text, nil
+	}
+
+	// A UNC prefix must contain both a server and a share: \\server\share
+	rem, _, err := Pair(
+		Not(seps),
+		Prefixed(Not(seps), OneOf(seps)))(text[2:])
+	if err != nil {
+		return "", text, err
+	}
+
+	end := len(text) - len(rem)
+	return text[:end], text[end:], nil
+}
@@ -0,0 +1,113 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilePath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		style chomp.PathStyle
+		input string
+		rem   string
+		path  chomp.FilePathParts
+	}{
+		{
+			name:  "POSIXAbsolute",
+			style: chomp.PathPOSIX,
+			input: "/usr/local//bin/ is on the PATH",
+			rem:   " is on the PATH",
+			path: chomp.FilePathParts{
+				Components: []string{"usr", "local", "bin"},
+				Absolute:   true,
+			},
+		},
+		{
+			name:  "POSIXRelative",
+			style: chomp.PathPOSIX,
+			input: "../chomp/./go.mod",
+			rem:   "",
+			path: chomp.FilePathParts{
+				Components: []string{"..", "chomp", ".", "go.mod"},
+			},
+		},
+		{
+			name:  "POSIXBackslashIsNotASeparator",
+			style: chomp.PathPOSIX,
+			input: `dir\file`,
+			rem:   "",
+			path: chomp.FilePathParts{
+				Components: []string{`dir\file`},
+			},
+		},
+		{
+			name:  "WindowsDriveAbsolute",
+			style: chomp.PathWindows,
+			input: `C:\Users\..\purpleclay/go.mod`,
+			rem:   "",
+			path: chomp.FilePathParts{
+				Volume:     "C:",
+				Components: []string{"Users", "..", "purpleclay", "go.mod"},
+				Absolute:   true,
+			},
+		},
+		{
+			name:  "WindowsDriveRelative",
+			style: chomp.PathWindows,
+			input: `D:chomp\go.mod`,
+			rem:   "",
+			path: chomp.FilePathParts{
+				Volume:     "D:",
+				Components: []string{"chomp", "go.mod"},
+			},
+		},
+		{
+			name:  "WindowsUNC",
+			style: chomp.PathWindows,
+			input: `\\server\share\docs\README.adoc`,
+			rem:   "",
+			path: chomp.FilePathParts{
+				Volume:     `\\server\share`,
+				Components: []string{"docs", "README.adoc"},
+				Absolute:   true,
+			},
+		},
+		{
+			name:  "WindowsRooted",
+			style: chomp.PathWindows,
+			input: `\temp`,
+			rem:   "",
+			path: chomp.FilePathParts{
+				Components: []string{"temp"},
+				Absolute:   true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, path, err := chomp.FilePath(tt.style)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.path, path)
+		})
+	}
+}
+
+func TestFilePathInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.FilePath(chomp.PathWindows)(`\\server`)
+	require.Error(t, err)
+
+	_, _, err = chomp.FilePath(chomp.PathPOSIX)(" /usr")
+	require.Error(t, err)
+}
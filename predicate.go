@@ -25,6 +25,16 @@ func (isDigit) String() string {
 	return "is_digit"
 }
 
+type isASCIIDigit struct{}
+
+func (isASCIIDigit) Match(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func (isASCIIDigit) String() string {
+	return "is_ascii_digit"
+}
+
 type isLetter struct{}
 
 func (isLetter) Match(r rune) bool {
@@ -55,6 +65,30 @@ func (isLineEnding) String() string {
 	return "is_line_ending"
 }
 
+type isSpace struct{}
+
+func (isSpace) Match(r rune) bool {
+	switch r {
+	case '\n', '\r', '\v', '\f', 0x85, 0x2028, 0x2029:
+		return false
+	}
+	return unicode.IsSpace(r)
+}
+
+func (isSpace) String() string {
+	return "is_space"
+}
+
+type isMultispace struct{}
+
+func (isMultispace) Match(r rune) bool {
+	return unicode.IsSpace(r)
+}
+
+func (isMultispace) String() string {
+	return "is_multispace"
+}
+
 var (
 	// IsDigit determines whether a rune is a decimal digit. A rune is classed
 	// as a digit if it is between the ASCII range of '0' or '9', or if it belongs
@@ -83,6 +117,16 @@ var (
 	// IsLineEnding determines whether a rune is one of the following ASCII
 	// line ending characters '\r' or '\n'.
 	IsLineEnding = isLineEnding{}
+
+	// IsSpace determines whether a rune is whitespace that does not break a line.
+	// This includes the ASCII space ' ' and tab '\t' characters, along with any
+	// Unicode space, such as a non-breaking space U+00A0. Line breaking characters
+	// '\n', '\r', '\v', '\f', U+0085, U+2028 and U+2029 are not matched.
+	IsSpace = isSpace{}
+
+	// IsMultispace determines whether a rune is any whitespace, including line
+	// breaking characters, as defined by [unicode.IsSpace].
+	IsMultispace = isMultispace{}
 )
 
 // While will scan the input text, testing each character against the provided
@@ -222,3 +266,36 @@ func WhileNotNM(p Predicate, n, m uint) Combinator[string] {
 		return s[pos:], s[:pos], nil
 	}
 }
+
+// TakeWhileMN will scan the input text, testing each rune against the provided
+// function. The function must match a minimum of n runes. Unlike [WhileNM],
+// scanning stops as soon as m runes have matched, even if more would match, and
+// a plain function can be used in place of a [Predicate].
+//
+//	chomp.TakeWhileMN(4, 4, func(r rune) bool {
+//		return unicode.Is(unicode.ASCII_Hex_Digit, r)
+//	})("00e9abc")
+//	// ("abc", "00e9", nil)
+func TakeWhileMN(n, m uint, f func(rune) bool) Combinator[string] {
+	return func(s string) (string, string, error) {
+		var count uint
+		pos := 0
+		for _, c := range s {
+			if count == m || !f(c) {
+				break
+			}
+			pos += len(string(c))
+			count++
+		}
+
+		if count < n {
+			return s, "", RangedParserError{
+				Err:  CombinatorParseError{Text: s, Type: "take_while"},
+				Exec: RangeExecution(count, n, m),
+				Type: "take_while_m_n",
+			}
+		}
+
+		return s[pos:], s[:pos], nil
+	}
+}
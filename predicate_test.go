@@ -2,6 +2,7 @@ package chomp_test
 
 import (
 	"testing"
+	"unicode"
 
 	"github.com/purpleclay/chomp"
 	"github.com/stretchr/testify/assert"
@@ -217,3 +218,57 @@ func TestWhileNotNM(t *testing.T) {
 		})
 	}
 }
+
+func TestTakeWhileMN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "StopsAtMax",
+			input: "00e9abc",
+			rem:   "9abc",
+			ext:   "00e",
+		},
+		{
+			name:  "StopsAtNoMatch",
+			input: "0e9 World",
+			rem:   " World",
+			ext:   "0e9",
+		},
+		{
+			name:  "Unicode",
+			input: "こんにちは, World!",
+			rem:   "ちは, World!",
+			ext:   "こんに",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.TakeWhileMN(2, 3, func(r rune) bool {
+				return unicode.Is(unicode.ASCII_Hex_Digit, r) || unicode.Is(unicode.Hiragana, r)
+			})(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestTakeWhileMNTooFew(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.TakeWhileMN(4, 4, func(r rune) bool {
+		return unicode.Is(unicode.ASCII_Hex_Digit, r)
+	})("0eg9")
+
+	require.EqualError(t, err, "(take_while_m_n) parser failed [count: 2 min: 4 max: 4]. (take_while) combinator failed to parse text '0eg9'")
+	assert.Equal(t, "0eg9", rem)
+}
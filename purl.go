@@ -0,0 +1,156 @@
+package chomp
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// PackageURLParts contains the individual parts of a parsed package URL (purl).
+type PackageURLParts struct {
+	// Type of the package, such as npm or golang. It is normalized to lowercase.
+	Type string
+
+	// Namespace of the package, such as a maven groupid, an npm scope or a
+	// GitHub user. Any percent-encoded characters are decoded.
+	Namespace string
+
+	// Name of the package. Any percent-encoded characters are decoded.
+	Name string
+
+	// Version of the package. Any percent-encoded characters are decoded.
+	Version string
+
+	// Qualifiers contains any extra qualifying data for the package, such as
+	// an OS or architecture. Keys are normalized to lowercase and values are
+	// percent-decoded. Any qualifier without a value is discarded.
+	Qualifiers map[string]string
+
+	// Subpath within the package, relative to its root. Any '.' or '..'
+	// segments are discarded.
+	Subpath string
+}
+
+// PackageURL will parse a package URL (purl), as defined by the purl specification,
+// in the format pkg:type/namespace/name@version?qualifiers#subpath. Only the type
+// and name are required. Components are percent-decoded where appropriate. Parsing
+// stops at the first whitespace character.
+//
+//	chomp.PackageURL()("pkg:npm/%40angular/core@16.2.0?arch=x64#packages/core")
+//	// ("", chomp.PackageURLParts{Type: "npm", Namespace: "@angular", Name: "core", Version: "16.2.0", Qualifiers: {"arch": "x64"}, Subpath: "packages/core"}, nil)
+func PackageURL() Combinator[PackageURLParts] {
+	return func(s string) (string, PackageURLParts, error) {
+		var purl PackageURLParts
+
+		// The scheme is case-insensitive
+		if len(s) < 4 || !strings.EqualFold(s[:4], "pkg:") {
+			return s, purl, ParserError{
+				Err:  CombinatorParseError{Input: "pkg:", Text: s, Type: "package_url_scheme"},
+				Type: "package_url",
+			}
+		}
+
+		rem, text, err := WhileNot(isPurlEnd{})(s[4:])
+		if err != nil {
+			return s, purl, ParserError{Err: err, Type: "package_url"}
+		}
+
+		purlErr := func(err error) (string, PackageURLParts, error) {
+			return s, PackageURLParts{}, ParserError{Err: err, Type: "package_url"}
+		}
+
+		if idx := strings.LastIndex(text, "#"); idx != -1 {
+			if purl.Subpath, err = purlSegments(text[idx+1:], true); err != nil {
+				return purlErr(err)
+			}
+			text = text[:idx]
+		}
+
+		if idx := strings.LastIndex(text, "?"); idx != -1 {
+			if purl.Qualifiers, err = purlQualifiers(text[idx+1:]); err != nil {
+				return purlErr(err)
+			}
+			text = text[:idx]
+		}
+
+		var ok bool
+		if purl.Type, text, ok = strings.Cut(strings.Trim(text, "/"), "/"); !ok || purl.Type == "" {
+			return purlErr(CombinatorParseError{Text: s, Type: "package_url_type"})
+		}
+		purl.Type = strings.ToLower(purl.Type)
+
+		if idx := strings.LastIndex(text, "@"); idx != -1 {
+			if purl.Version, err = url.PathUnescape(text[idx+1:]); err != nil {
+				return purlErr(err)
+			}
+			text = text[:idx]
+		}
+
+		text = strings.TrimRight(text, "/")
+		name := text
+		if idx := strings.LastIndex(text, "/"); idx != -1 {
+			name = text[idx+1:]
+			if purl.Namespace, err = purlSegments(text[:idx], false); err != nil {
+				return purlErr(err)
+			}
+		}
+
+		if purl.Name, err = url.PathUnescape(name); err != nil {
+			return purlErr(err)
+		}
+
+		if purl.Name == "" {
+			return purlErr(CombinatorParseError{Text: s, Type: "package_url_name"})
+		}
+
+		return rem, purl, nil
+	}
+}
+
+type isPurlEnd struct{}
+
+func (isPurlEnd) Match(r rune) bool {
+	return unicode.IsSpace(r)
+}
+
+func (isPurlEnd) String() string {
+	return "is_purl_end"
+}
+
+func purlSegments(path string, subpath bool) (string, error) {
+	var segments []string
+	for _, seg := range strings.Split(strings.Trim(path, "/"), "/") {
+		if seg == "" || (subpath && (seg == "." || seg == "..")) {
+			continue
+		}
+
+		decoded, err := url.PathUnescape(seg)
+		if err != nil {
+			return "", err
+		}
+		segments = append(segments, decoded)
+	}
+
+	return strings.Join(segments, "/"), nil
+}
+
+func purlQualifiers(query string) (map[string]string, error) {
+	qualifiers := map[string]string{}
+	for _, pair := range strings.Split(query, "&") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, CombinatorParseError{Text: pair, Type: "package_url_qualifier"}
+		}
+
+		decoded, err := url.PathUnescape(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if decoded != "" {
+			qualifiers[strings.ToLower(key)] = decoded
+		}
+	}
+
+	return qualifiers, nil
+}
@@ -0,0 +1,127 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.PackageURLParts
+	}{
+		{
+			name:  "Complete",
+			input: "pkg:npm/%40angular/core@16.2.0?arch=x64&OS=linux#packages/core",
+			expected: chomp.PackageURLParts{
+				Type:       "npm",
+				Namespace:  "@angular",
+				Name:       "core",
+				Version:    "16.2.0",
+				Qualifiers: map[string]string{"arch": "x64", "os": "linux"},
+				Subpath:    "packages/core",
+			},
+		},
+		{
+			name:  "RawScope",
+			input: "pkg:npm/@scope/name@1.0.0",
+			expected: chomp.PackageURLParts{
+				Type:      "npm",
+				Namespace: "@scope",
+				Name:      "name",
+				Version:   "1.0.0",
+			},
+		},
+		{
+			name:  "TypeAndName",
+			input: "PKG:PyPI/django",
+			expected: chomp.PackageURLParts{
+				Type: "pypi",
+				Name: "django",
+			},
+		},
+		{
+			name:  "NestedNamespace",
+			input: "pkg:golang/github.com/purpleclay/chomp@v1.4.0#./sequence/../parser",
+			expected: chomp.PackageURLParts{
+				Type:      "golang",
+				Namespace: "github.com/purpleclay",
+				Name:      "chomp",
+				Version:   "v1.4.0",
+				Subpath:   "sequence/parser",
+			},
+		},
+		{
+			name:  "EncodedVersionAndEmptyQualifier",
+			input: "pkg:maven/org.apache/commons-io@2.0%2Bbuild?classifier=&type=jar",
+			expected: chomp.PackageURLParts{
+				Type:       "maven",
+				Namespace:  "org.apache",
+				Name:       "commons-io",
+				Version:    "2.0+build",
+				Qualifiers: map[string]string{"type": "jar"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, purl, err := chomp.PackageURL()(tt.input + " is vulnerable")
+
+			require.NoError(t, err)
+			assert.Equal(t, " is vulnerable", rem)
+			assert.Equal(t, tt.expected, purl)
+		})
+	}
+}
+
+func TestPackageURLInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "MissingScheme",
+			input: "npm/left-pad@1.3.0",
+		},
+		{
+			name:  "MissingName",
+			input: "pkg:npm",
+		},
+		{
+			name:  "EmptyName",
+			input: "pkg:npm/@1.3.0",
+		},
+		{
+			name:  "InvalidEncoding",
+			input: "pkg:npm/left%2-pad",
+		},
+		{
+			name:  "MalformedQualifier",
+			input: "pkg:npm/left-pad?arch",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.PackageURL()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package chomp
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// SedAddrKind identifies the type of a single sed address.
+type SedAddrKind int
+
+const (
+	// SedLine selects a line by its number.
+	SedLine SedAddrKind = iota
+
+	// SedLast selects the last line of input, $.
+	SedLast
+
+	// SedRegex selects any line matching a regular expression.
+	SedRegex
+)
+
+// SedAddrPart contains the parsed details of a single sed address.
+// The fields that are set depend on its [SedAddrKind].
+type SedAddrPart struct {
+	// Kind of sed address.
+	Kind SedAddrKind
+
+	// Line number. Only set for [SedLine].
+	Line int
+
+	// Regex is the regular expression, without its delimiters. Any
+	// escape sequences are left intact. Only set for [SedRegex].
+	Regex string
+
+	// Flags that modify the regular expression, I (case-insensitive) or
+	// M (multi-line). Only set for [SedRegex].
+	Flags string
+}
+
+// SedAddr contains the parsed details of a sed address, which selects
+// the lines a command applies to.
+type SedAddr struct {
+	// Start is the first, or only, address.
+	Start SedAddrPart
+
+	// End is the address that closes a range. Only set if Range is true.
+	End SedAddrPart
+
+	// Range is true if the address selects a range of lines, addr1,addr2.
+	Range bool
+
+	// Negate is true if the address is followed by a '!', inverting the
+	// selected lines.
+	Negate bool
+}
+
+// SedAddress will parse a sed address, which can either be a line number (5),
+// the last line ($), or a regular expression. A regular expression is either
+// delimited by slashes, /regex/, or by a custom character, \cregexc. Within a
+// regular expression, a delimiter can be escaped with a backslash. A range is
+// formed by separating two addresses with a comma, 1,/END/. An address can
+// be negated by following it with a '!'.
+//
+//	chomp.SedAddress()("1,/^END$/I d")
+//	// (" d", chomp.SedAddr{Start: {Kind: chomp.SedLine, Line: 1}, End: {Kind: chomp.SedRegex, Regex: "^END$", Flags: "I"}, Range: true}, nil)
+func SedAddress() Combinator[SedAddr] {
+	return func(s string) (string, SedAddr, error) {
+		var addr SedAddr
+
+		rem, start, err := sedAddrPart(s)
+		if err != nil {
+			return s, SedAddr{}, ParserError{Err: err, Type: "sed_address"}
+		}
+		addr.Start = start
+
+		if next, _, err := Tag(",")(rem); err == nil {
+			if rem, addr.End, err = sedAddrPart(next); err != nil {
+				return s, SedAddr{}, ParserError{Err: err, Type: "sed_address"}
+			}
+			addr.Range = true
+		}
+
+		if next, _, err := Tag("!")(rem); err == nil {
+			rem = next
+			addr.Negate = true
+		}
+
+		return rem, addr, nil
+	}
+}
+
+func sedAddrPart(s string) (string, SedAddrPart, error) {
+	if rem, _, err := Tag("$")(s); err == nil {
+		return rem, SedAddrPart{Kind: SedLast}, nil
+	}
+
+	if rem, ext, err := While(IsDigit)(s); err == nil {
+		line, err := strconv.Atoi(ext)
+		if err != nil {
+			return s, SedAddrPart{}, ParserError{Err: err, Type: "sed_line"}
+		}
+		return rem, SedAddrPart{Kind: SedLine, Line: line}, nil
+	}
+
+	delim := '/'
+	rem, _, err := Tag("/")(s)
+	if err != nil {
+		if rem, _, err = Tag(`\`)(s); err != nil {
+			return s, SedAddrPart{}, CombinatorParseError{Text: s, Type: "sed_address_part"}
+		}
+
+		var size int
+		if delim, size = utf8.DecodeRuneInString(rem); delim == utf8.RuneError || delim == '\\' || delim == '\n' {
+			return s, SedAddrPart{}, CombinatorParseError{Text: s, Type: "sed_regex"}
+		}
+		rem = rem[size:]
+	}
+
+	end := -1
+	escaped := false
+	for i, c := range rem {
+		if escaped {
+			escaped = false
+			continue
+		}
+
+		if c == '\\' {
+			escaped = true
+		} else if c == delim {
+			end = i
+			break
+		}
+	}
+
+	if end == -1 {
+		return s, SedAddrPart{}, CombinatorParseError{Input: string(delim), Text: s, Type: "sed_regex"}
+	}
+	regex := rem[:end]
+
+	rem = rem[end+len(string(delim)):]
+	rem, flags, _ := WhileN(isSedRegexFlag{}, 0)(rem)
+	return rem, SedAddrPart{Kind: SedRegex, Regex: regex, Flags: flags}, nil
+}
+
+type isSedRegexFlag struct{}
+
+func (isSedRegexFlag) Match(r rune) bool {
+	return r == 'I' || r == 'M'
+}
+
+func (isSedRegexFlag) String() string {
+	return "is_sed_regex_flag"
+}
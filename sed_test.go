@@ -0,0 +1,118 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSedAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.SedAddr
+	}{
+		{
+			name:  "Line",
+			input: "5p",
+			expected: chomp.SedAddr{
+				Start: chomp.SedAddrPart{Kind: chomp.SedLine, Line: 5},
+			},
+		},
+		{
+			name:  "Last",
+			input: "$p",
+			expected: chomp.SedAddr{
+				Start: chomp.SedAddrPart{Kind: chomp.SedLast},
+			},
+		},
+		{
+			name:  "Regex",
+			input: `/^\/usr\/bin/p`,
+			expected: chomp.SedAddr{
+				Start: chomp.SedAddrPart{Kind: chomp.SedRegex, Regex: `^\/usr\/bin`},
+			},
+		},
+		{
+			name:  "CustomDelimiter",
+			input: `\#/usr/bin#Ip`,
+			expected: chomp.SedAddr{
+				Start: chomp.SedAddrPart{Kind: chomp.SedRegex, Regex: "/usr/bin", Flags: "I"},
+			},
+		},
+		{
+			name:  "Range",
+			input: "1,/^END$/Ip",
+			expected: chomp.SedAddr{
+				Start: chomp.SedAddrPart{Kind: chomp.SedLine, Line: 1},
+				End:   chomp.SedAddrPart{Kind: chomp.SedRegex, Regex: "^END$", Flags: "I"},
+				Range: true,
+			},
+		},
+		{
+			name:  "NegatedRange",
+			input: "/BEGIN/,$!p",
+			expected: chomp.SedAddr{
+				Start:  chomp.SedAddrPart{Kind: chomp.SedRegex, Regex: "BEGIN"},
+				End:    chomp.SedAddrPart{Kind: chomp.SedLast},
+				Range:  true,
+				Negate: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, addr, err := chomp.SedAddress()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, "p", rem)
+			assert.Equal(t, tt.expected, addr)
+		})
+	}
+}
+
+func TestSedAddressInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Command",
+			input: "p",
+		},
+		{
+			name:  "UnterminatedRegex",
+			input: "/^END",
+		},
+		{
+			name:  "MissingRangeEnd",
+			input: "1,p",
+		},
+		{
+			name:  "BackslashDelimiter",
+			input: `\\regex\`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.SedAddress()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package chomp
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SemVerParts contains the individual parts of a parsed semantic version.
+type SemVerParts struct {
+	// Major version, incremented for incompatible API changes.
+	Major int
+
+	// Minor version, incremented for backwards compatible features.
+	Minor int
+
+	// Patch version, incremented for backwards compatible bug fixes.
+	Patch int
+
+	// Prerelease contains the dot separated pre-release identifiers, such
+	// as alpha.1, without the leading '-'.
+	Prerelease string
+
+	// Build contains the dot separated build metadata identifiers, such
+	// as exp.sha.5114f85, without the leading '+'.
+	Build string
+}
+
+type isSemVerIdent struct{}
+
+func (isSemVerIdent) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-'
+}
+
+func (isSemVerIdent) String() string {
+	return "is_semver_ident"
+}
+
+// SemVer will parse a semantic version, as defined by the semantic versioning
+// specification, in the format major.minor.patch-prerelease+build. Both the
+// pre-release and build metadata are optional. Numeric identifiers, including
+// the major, minor and patch versions, cannot contain leading zeros. A 'v'
+// prefix is not supported.
+//
+//	chomp.SemVer()("1.0.0-alpha.1+exp.sha.5114f85 released")
+//	// (" released", chomp.SemVerParts{Major: 1, Minor: 0, Patch: 0, Prerelease: "alpha.1", Build: "exp.sha.5114f85"}, nil)
+func SemVer() Combinator[SemVerParts] {
+	return func(s string) (string, SemVerParts, error) {
+		var ver SemVerParts
+
+		rem, ext, err := All(
+			While(isASCIIDigit{}),
+			Tag("."),
+			While(isASCIIDigit{}),
+			Tag("."),
+			While(isASCIIDigit{}))(s)
+		if err != nil {
+			return s, ver, ParserError{Err: err, Type: "semver"}
+		}
+
+		for i, n := range []*int{&ver.Major, &ver.Minor, &ver.Patch} {
+			if *n, err = semVerNumber(ext[i*2]); err != nil {
+				return s, SemVerParts{}, ParserError{Err: err, Type: "semver"}
+			}
+		}
+
+		if next, _, err := Tag("-")(rem); err == nil {
+			if rem, ver.Prerelease, err = semVerIdents(next, true); err != nil {
+				return s, SemVerParts{}, ParserError{Err: err, Type: "semver"}
+			}
+		}
+
+		if next, _, err := Tag("+")(rem); err == nil {
+			if rem, ver.Build, err = semVerIdents(next, false); err != nil {
+				return s, SemVerParts{}, ParserError{Err: err, Type: "semver"}
+			}
+		}
+
+		return rem, ver, nil
+	}
+}
+
+func semVerNumber(s string) (int, error) {
+	if len(s) > 1 && s[0] == '0' {
+		return 0, CombinatorParseError{Text: s, Type: "semver_number"}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, ParserError{Err: err, Type: "semver_number"}
+	}
+
+	return n, nil
+}
+
+// semVerIdents matches a series of dot separated identifiers. Numeric
+// identifiers within a pre-release cannot contain leading zeros
+func semVerIdents(s string, prerelease bool) (string, string, error) {
+	rem, idents, err := SepEndList(Tag("."), While(isSemVerIdent{}))(s)
+	if err != nil || len(idents) == 0 || strings.HasSuffix(s[:len(s)-len(rem)], ".") {
+		return s, "", CombinatorParseError{Text: s, Type: "semver_identifier"}
+	}
+
+	if prerelease {
+		for _, ident := range idents {
+			if strings.Trim(ident, "0123456789") == "" {
+				if _, err := semVerNumber(ident); err != nil {
+					return s, "", err
+				}
+			}
+		}
+	}
+
+	return rem, s[:len(s)-len(rem)], nil
+}
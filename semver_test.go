@@ -0,0 +1,124 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemVer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.SemVerParts
+	}{
+		{
+			name:     "Core",
+			input:    "1.22.333",
+			expected: chomp.SemVerParts{Major: 1, Minor: 22, Patch: 333},
+		},
+		{
+			name:     "Zeros",
+			input:    "0.0.0",
+			expected: chomp.SemVerParts{},
+		},
+		{
+			name:     "Prerelease",
+			input:    "1.0.0-alpha.0.x-y.0a",
+			expected: chomp.SemVerParts{Major: 1, Prerelease: "alpha.0.x-y.0a"},
+		},
+		{
+			name:     "Build",
+			input:    "1.0.0+20130313144700.001",
+			expected: chomp.SemVerParts{Major: 1, Build: "20130313144700.001"},
+		},
+		{
+			name:  "PrereleaseAndBuild",
+			input: "2.1.7-rc.1+exp.sha.5114f85",
+			expected: chomp.SemVerParts{
+				Major:      2,
+				Minor:      1,
+				Patch:      7,
+				Prerelease: "rc.1",
+				Build:      "exp.sha.5114f85",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ver, err := chomp.SemVer()(tt.input + " released")
+
+			require.NoError(t, err)
+			assert.Equal(t, " released", rem)
+			assert.Equal(t, tt.expected, ver)
+		})
+	}
+}
+
+func TestSemVerInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "MissingPatch",
+			input: "1.2",
+			err:   "(tag) combinator failed to parse text '' with input '.'",
+		},
+		{
+			name:  "LeadingZero",
+			input: "1.02.3",
+			err:   "(semver_number) combinator failed to parse text '02'",
+		},
+		{
+			name:  "VPrefix",
+			input: "v1.2.3",
+			err:   "(is_ascii_digit) combinator failed",
+		},
+		{
+			name:  "PrereleaseLeadingZero",
+			input: "1.2.3-alpha.01",
+			err:   "(semver_number) combinator failed to parse text '01'",
+		},
+		{
+			name:  "EmptyPrerelease",
+			input: "1.2.3-+build",
+			err:   "(semver_identifier) combinator failed",
+		},
+		{
+			name:  "EmptyIdentifier",
+			input: "1.2.3-alpha..1",
+			err:   "(semver_identifier) combinator failed",
+		},
+		{
+			name:  "TrailingDot",
+			input: "1.2.3+build.",
+			err:   "(semver_identifier) combinator failed",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.SemVer()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(semver) parser failed")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package chomp
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
 // Pair will scan the input text and match each [Combinator] in turn.
 // Both combinators must match.
 //
@@ -45,7 +51,7 @@ func combine(in []string, elems ...any) []string {
 //		chomp.Tag(", "),
 //		chomp.Tag("World"))("Hello, World!")
 //	// ("!", []string{"Hello", "World"}, nil)
-func SepPair[T, U, V Result](c1 Combinator[T], sep Combinator[U], c2 Combinator[V]) Combinator[[]string] {
+func SepPair[T Result, U any, V Result](c1 Combinator[T], sep Combinator[U], c2 Combinator[V]) Combinator[[]string] {
 	return func(s string) (string, []string, error) {
 		rem, out1, err := c1(s)
 		if err != nil {
@@ -131,6 +137,175 @@ func RepeatRange[T Result](c Combinator[T], n, m uint) Combinator[[]string] {
 	}
 }
 
+// RepeatTill will scan the input text and match the [Combinator] at least
+// the defined number of times, and then continue matching it until the end
+// [Combinator] matches. The output of the end combinator is discarded. It
+// fails if the end combinator never matches or if the minimum number of
+// executions is not met.
+//
+//	chomp.RepeatTill(chomp.Suffixed(chomp.While(chomp.IsDigit), chomp.Tag(",")), chomp.Tag(";"), 2)("1,2,3,;4")
+//	// ("4", []string{"1", "2", "3"}, nil)
+func RepeatTill[T, U Result](c Combinator[T], end Combinator[U], n uint) Combinator[[]string] {
+	return func(s string) (string, []string, error) {
+		var ext []string
+
+		rem := s
+		for i := uint(0); ; i++ {
+			var endErr error
+			if i >= n {
+				next, _, err := end(rem)
+				if err == nil {
+					return next, ext, nil
+				}
+				endErr = err
+			}
+
+			next, out, err := c(rem)
+			if err != nil {
+				if endErr != nil {
+					err = endErr
+				}
+				return s, nil, RangedParserError{Err: err, Exec: RangeExecution(i, n), Type: "repeat_till"}
+			}
+
+			// A combinator that consumes no input would never reach the end
+			if next == rem {
+				return s, nil, RangedParserError{
+					Err:  CombinatorParseError{Text: rem, Type: "repeat_till"},
+					Exec: RangeExecution(i, n),
+					Type: "repeat_till",
+				}
+			}
+
+			ext = combine(ext, out)
+			rem = next
+		}
+	}
+}
+
+// SepEndList will scan the input text and match a list of elements, each
+// separated by the separator [Combinator]. A single trailing separator is
+// consumed and discarded. As with [ManyN] using a minimum of zero, the list
+// can be empty and will never fail.
+//
+//	chomp.SepEndList(chomp.Tag(","), chomp.While(chomp.IsLetter))("a,b,c,;")
+//	// (";", []string{"a", "b", "c"}, nil)
+func SepEndList[T, U Result](sep Combinator[U], element Combinator[T]) Combinator[[]string] {
+	return func(s string) (string, []string, error) {
+		rem, out, err := element(s)
+		if err != nil {
+			return s, nil, nil
+		}
+		ext := combine(nil, out)
+
+		for {
+			after, _, err := sep(rem)
+			if err != nil {
+				break
+			}
+
+			next, out, err := element(after)
+			if err != nil {
+				rem = after
+				break
+			}
+
+			if next == rem {
+				break
+			}
+
+			ext = combine(ext, out)
+			rem = next
+		}
+
+		return rem, ext, nil
+	}
+}
+
+// ChainLeft will match one or more operands separated by an operator, such as
+// 1 + 2 - 3, folding them into a single value using the reduce function. Operators
+// are left-associative, with the reduce function being called as each operator
+// is matched, ((1 + 2) - 3). If an operator is not followed by an operand, it is
+// not consumed. Multiple extracted values from an operand are joined into a
+// string, in the same way as [Flatten]. It is a stack-safe alternative to
+// writing a left-recursive grammar, see [Lazy].
+//
+//	chomp.ChainLeft(
+//		chomp.While(chomp.IsDigit),
+//		chomp.OneOf("+-"),
+//		func(left, right, op string) string { return "(" + left + op + right + ")" })("1+2-3")
+//	// ("", "((1+2)-3)", nil)
+func ChainLeft[T Result](operand Combinator[T], op Combinator[string], reduce func(left, right, op string) string) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, operands, ops, err := chain(operand, op)(s)
+		if err != nil {
+			return s, "", ParserError{Err: err, Type: "chain_left"}
+		}
+
+		acc := operands[0]
+		for i, o := range ops {
+			acc = reduce(acc, operands[i+1], o)
+		}
+		return rem, acc, nil
+	}
+}
+
+// ChainRight will match one or more operands separated by an operator, such as
+// 2 ^ 3 ^ 2, folding them into a single value using the reduce function. It
+// behaves like [ChainLeft], but operators are right-associative, (2 ^ (3 ^ 2)),
+// with the reduce function being called once all operands have been matched.
+//
+//	chomp.ChainRight(
+//		chomp.While(chomp.IsDigit),
+//		chomp.Tag("^"),
+//		func(left, right, op string) string { return "(" + left + op + right + ")" })("2^3^2")
+//	// ("", "(2^(3^2))", nil)
+func ChainRight[T Result](operand Combinator[T], op Combinator[string], reduce func(left, right, op string) string) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, operands, ops, err := chain(operand, op)(s)
+		if err != nil {
+			return s, "", ParserError{Err: err, Type: "chain_right"}
+		}
+
+		acc := operands[len(operands)-1]
+		for i := len(ops) - 1; i >= 0; i-- {
+			acc = reduce(operands[i], acc, ops[i])
+		}
+		return rem, acc, nil
+	}
+}
+
+// chain matches operand (op operand)*, returning all operands and operators
+// in the order they were matched
+func chain[T Result](operand Combinator[T], op Combinator[string]) func(string) (string, []string, []string, error) {
+	return func(s string) (string, []string, []string, error) {
+		rem, out, err := operand(s)
+		if err != nil {
+			return s, nil, nil, err
+		}
+		operands := []string{strings.Join(combine(nil, out), "")}
+
+		var ops []string
+		for {
+			after, o, err := op(rem)
+			if err != nil {
+				break
+			}
+
+			next, out, err := operand(after)
+			if err != nil || next == rem {
+				break
+			}
+
+			ops = append(ops, o)
+			operands = append(operands, strings.Join(combine(nil, out), ""))
+			rem = next
+		}
+
+		return rem, operands, ops, nil
+	}
+}
+
 // Delimited will match a series of combinators against the input text. All
 // must match, with the delimiters being discarded.
 //
@@ -139,7 +314,7 @@ func RepeatRange[T Result](c Combinator[T], n, m uint) Combinator[[]string] {
 //		chomp.Tag("Hello, World!"),
 //		chomp.Tag("'"))("'Hello, World!'")
 //	// ("", "Hello, World!", nil)
-func Delimited[T, U, V Result](left Combinator[T], str Combinator[U], right Combinator[V]) Combinator[U] {
+func Delimited[T, U, V any](left Combinator[T], str Combinator[U], right Combinator[V]) Combinator[U] {
 	return func(s string) (string, U, error) {
 		var def U
 
@@ -162,6 +337,46 @@ func Delimited[T, U, V Result](left Combinator[T], str Combinator[U], right Comb
 	}
 }
 
+// Between will discard any whitespace matched by the ws [Combinator] from the
+// input text both before and after matching a [Combinator]. Matching the
+// whitespace is optional, with nothing discarded if ws fails. If the
+// [Combinator] fails, no input text is consumed. Use [Ws] to discard all
+// whitespace, including line endings, without providing a [Combinator].
+//
+//	chomp.Between(
+//		chomp.Spaces0(),
+//		chomp.Tag("Hello"))("  Hello  , World!")
+//	// (", World!", "Hello", nil)
+func Between[T any](ws Combinator[string], c Combinator[T]) Combinator[T] {
+	return func(s string) (string, T, error) {
+		rem := s
+		if next, _, err := ws(rem); err == nil {
+			rem = next
+		}
+
+		rem, ext, err := c(rem)
+		if err != nil {
+			var def T
+			return s, def, ParserError{Err: err, Type: "between"}
+		}
+
+		if next, _, err := ws(rem); err == nil {
+			rem = next
+		}
+		return rem, ext, nil
+	}
+}
+
+// Surrounded will match a [Combinator] that is surrounded by the same
+// delimiter on both sides. Both delimiters are discarded. It is a
+// convenience for [Delimited].
+//
+//	chomp.Surrounded("**", chomp.Until("**"))("**Hello**, World!")
+//	// (", World!", "Hello", nil)
+func Surrounded[U any](delim string, c Combinator[U]) Combinator[U] {
+	return Delimited(Tag(delim), c, Tag(delim))
+}
+
 // QuoteDouble will match any text delimited (or surrounded) by a
 // pair of "double quotes".
 //
@@ -184,6 +399,81 @@ func QuoteSingle() Combinator[string] {
 	}
 }
 
+// EscapedString will match any text delimited (or surrounded) by a pair of
+// quotes. Any quote preceded by the escape character will not terminate the
+// text. The text is returned without its quotes, but with all escape
+// sequences intact. A closing quote must exist, and an escape character
+// must always be followed by another character.
+//
+//	chomp.EscapedString('"', '\\')(`"he said \"hi\"" to me`)
+//	// (" to me", `he said \"hi\"`, nil)
+func EscapedString(quote, escape rune) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, _, err := Tag(string(quote))(s)
+		if err != nil {
+			return s, "", ParserError{Err: err, Type: "escaped_string"}
+		}
+
+		escaped := false
+		for i, c := range rem {
+			switch {
+			case escaped:
+				escaped = false
+			case c == escape:
+				escaped = true
+			case c == quote:
+				return rem[i+len(string(quote)):], rem[:i], nil
+			}
+		}
+
+		return s, "", CombinatorParseError{Input: string(quote), Text: s, Type: "escaped_string"}
+	}
+}
+
+// EscapedTransform will match any text delimited (or surrounded) by a pair
+// of quotes, in the same way as [EscapedString]. Each escaped character is
+// decoded using the provided function, with the result replacing the entire
+// escape sequence. Any error returned by the decode function is surfaced.
+//
+//	chomp.EscapedTransform('"', '\\', func(r rune) (string, error) {
+//		switch r {
+//		case 'n':
+//			return "\n", nil
+//		case '"', '\\':
+//			return string(r), nil
+//		}
+//		return "", fmt.Errorf("unknown escape sequence: %c", r)
+//	})(`"he said \"hi\"\n" to me`)
+//	// (" to me", "he said \"hi\"\n", nil)
+func EscapedTransform(quote, escape rune, decode func(rune) (string, error)) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, ext, err := EscapedString(quote, escape)(s)
+		if err != nil {
+			return rem, "", err
+		}
+
+		var buf strings.Builder
+		escaped := false
+		for _, c := range ext {
+			switch {
+			case escaped:
+				out, err := decode(c)
+				if err != nil {
+					return s, "", ParserError{Err: err, Type: "escaped_transform"}
+				}
+				buf.WriteString(out)
+				escaped = false
+			case c == escape:
+				escaped = true
+			default:
+				buf.WriteRune(c)
+			}
+		}
+
+		return rem, buf.String(), nil
+	}
+}
+
 // BracketSquare will match any text delimited (or surrounded) by
 // a pair of [square brackets].
 //
@@ -217,21 +507,80 @@ func BracketAngled() Combinator[string] {
 	}
 }
 
+// Balanced will match any text delimited (or surrounded) by a pair of
+// opening and closing runes, where any nested pairs must also be balanced.
+// Unlike [Parentheses], a closing rune within a nested pair will not
+// terminate the text. The text is returned without its outermost pair.
+//
+//	chomp.Balanced('(', ')')("(a(b)c) + d")
+//	// (" + d", "a(b)c", nil)
+func Balanced(open, close rune) Combinator[string] {
+	return balanced(open, close, nil)
+}
+
+// BalancedEscaped will match any text delimited (or surrounded) by a pair of
+// opening and closing runes, in the same way as [Balanced]. Any opening or
+// closing rune preceded by the escape character is ignored. The text is
+// returned with all escape sequences intact. An escape character must
+// always be followed by another character.
+//
+//	chomp.BalancedEscaped('(', ')', '\\')(`(a\)b) + c`)
+//	// (" + c", `a\)b`, nil)
+func BalancedEscaped(open, close, escape rune) Combinator[string] {
+	return balanced(open, close, &escape)
+}
+
+func balanced(open, close rune, escape *rune) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, _, err := Tag(string(open))(s)
+		if err != nil {
+			return s, "", ParserError{Err: err, Type: "balanced"}
+		}
+
+		depth := 0
+		escaped := false
+		for i, c := range rem {
+			switch {
+			case escaped:
+				escaped = false
+			case escape != nil && c == *escape:
+				escaped = true
+			case c == open:
+				depth++
+			case c == close:
+				if depth == 0 {
+					return rem[i+len(string(close)):], rem[:i], nil
+				}
+				depth--
+			}
+		}
+
+		return s, "", CombinatorParseError{Input: string(close), Text: s, Type: "balanced"}
+	}
+}
+
 // First will match the input text against a series of [Combinator]s.
 // Matching stops as soon as the first combinator succeeds. One [Combinator]
 // must match. For better performance, try and order the combinators from
-// most to least likely to match.
+// most to least likely to match. Matching also stops if a combinator fails
+// with a [FatalError], see [Cut].
 //
 //	chomp.First(
 //		chomp.Tag("Good Morning"),
 //		chomp.Tag("Hello"))("Good Morning, World!")
 //	// (" ,World!", "Good Morning", nil)
-func First[T Result](c ...Combinator[T]) Combinator[T] {
+func First[T any](c ...Combinator[T]) Combinator[T] {
 	return func(s string) (string, T, error) {
 		for _, comb := range c {
-			if rem, ext, err := comb(s); err == nil {
+			rem, ext, err := comb(s)
+			if err == nil {
 				return rem, ext, nil
 			}
+
+			if isFatal(err) {
+				var out T
+				return s, out, err
+			}
 		}
 
 		var out T
@@ -239,6 +588,74 @@ func First[T Result](c ...Combinator[T]) Combinator[T] {
 	}
 }
 
+// Alt will match the input text against a series of [Combinator]s. Matching
+// stops as soon as the first combinator succeeds. One [Combinator] must match.
+// It behaves like [First], but if every combinator fails, an [AltError] is
+// returned containing the error from each branch. Branches are ordered by
+// the amount of input consumed before failing, as the branch that got the
+// furthest is usually the intended path. Prefer [First] when a detailed error
+// is not needed. A [FatalError] raised by a branch is returned immediately,
+// see [Cut].
+//
+//	chomp.Alt(
+//		chomp.Tag("Good Morning"),
+//		chomp.Tag("Hello"))("Good Evening, World!")
+//	// ("Good Evening, World!", "", "(alt) parser failed. [1] (tag) combinator failed...")
+func Alt[T any](c ...Combinator[T]) Combinator[T] {
+	return func(s string) (string, T, error) {
+		type branch struct {
+			consumed int
+			err      error
+		}
+
+		branches := make([]branch, 0, len(c))
+		for _, comb := range c {
+			rem, ext, err := comb(s)
+			if err == nil {
+				return rem, ext, nil
+			}
+
+			if isFatal(err) {
+				var out T
+				return s, out, err
+			}
+			branches = append(branches, branch{consumed: len(s) - len(rem), err: err})
+		}
+
+		sort.SliceStable(branches, func(i, j int) bool {
+			return branches[i].consumed > branches[j].consumed
+		})
+
+		errs := make([]error, 0, len(branches))
+		for _, b := range branches {
+			errs = append(errs, b.err)
+		}
+
+		var out T
+		return s, out, AltError{Errs: errs}
+	}
+}
+
+// FirstMapped will match the input text against a series of [MappedCombinator]s,
+// returning the mapped value of the first to succeed. It is ideal for parsing
+// a tagged union, where each branch produces the same type. One [MappedCombinator]
+// must match. If every branch fails, an [AltError] is returned in the same way
+// as [Alt]. Branches with different input types can instead be converted into
+// a [Combinator] and passed to [First] or [Alt].
+//
+//	chomp.FirstMapped(
+//		chomp.Map(chomp.Tag("true"), func(string) bool { return true }),
+//		chomp.Map(chomp.Tag("false"), func(string) bool { return false }))("false,")
+//	// (",", false, nil)
+func FirstMapped[S, T any](c ...MappedCombinator[S, T]) MappedCombinator[S, T] {
+	combs := make([]Combinator[S], 0, len(c))
+	for _, comb := range c {
+		combs = append(combs, Combinator[S](comb))
+	}
+
+	return MappedCombinator[S, T](Alt(combs...))
+}
+
 // All will match the input text against a series of [Combinator]s.
 // All combinators must match in the order provided.
 //
@@ -265,6 +682,65 @@ func All[T Result](c ...Combinator[T]) Combinator[[]string] {
 	}
 }
 
+// Permutation will match the input text against a series of [Combinator]s.
+// Each combinator must match exactly once, but in any order. Results are
+// returned in the order the combinators were provided, not the order they
+// were matched.
+//
+//	chomp.Permutation(
+//		chomp.Tag("Hello"),
+//		chomp.Tag(", "),
+//		chomp.Tag("World"))("World, Hello!")
+//	// ("!", []string{"Hello", ", ", "World"}, nil)
+func Permutation[T Result](c ...Combinator[T]) Combinator[[]string] {
+	return func(s string) (string, []string, error) {
+		outs := make([]T, len(c))
+		matched := make([]bool, len(c))
+
+		rem := s
+		for count := 0; count < len(c); {
+			progress := false
+			for i, comb := range c {
+				if matched[i] {
+					continue
+				}
+
+				tmpRem, out, err := comb(rem)
+				if err != nil {
+					continue
+				}
+
+				rem = tmpRem
+				outs[i] = out
+				matched[i] = true
+				progress = true
+				count++
+			}
+
+			if !progress {
+				var missing []int
+				for i := range matched {
+					if !matched[i] {
+						missing = append(missing, i)
+					}
+				}
+
+				return rem, nil, ParserError{
+					Err:  fmt.Errorf("combinators at index %v did not match text '%s'", missing, rem),
+					Type: "permutation",
+				}
+			}
+		}
+
+		var ext []string
+		for _, out := range outs {
+			ext = combine(ext, out)
+		}
+
+		return rem, ext, nil
+	}
+}
+
 // Many will scan the input text, and it must match the [Combinator] at least
 // once. This [Combinator] is greedy and will continuously execute until the first
 // failed match. It is the equivalent of calling [ManyN] with an argument of 1.
@@ -312,44 +788,183 @@ func ManyN[T Result](c Combinator[T], n uint) Combinator[[]string] {
 	}
 }
 
-// Prefixed will scan the input text for a defined prefix and discard it
+// CountWhile will scan the input text and match the [Combinator] as many times
+// as possible, returning the number of successful matches. The [Combinator] must
+// match at least once. Unlike [Many], no matched text is retained. Matching
+// stops if the [Combinator] no longer consumes any text.
+//
+//	chomp.CountWhile(chomp.Tag("#"))("### Heading")
+//	// (" Heading", 3, nil)
+func CountWhile[T any](c Combinator[T]) func(string) (string, int, error) {
+	return countWhileN(c, 1)
+}
+
+// CountWhile0 will scan the input text and match the [Combinator] as many times
+// as possible, returning the number of successful matches. Unlike [CountWhile],
+// the [Combinator] does not need to match and it will never return an error.
+//
+//	chomp.CountWhile0(chomp.Tag("#"))("Heading")
+//	// ("Heading", 0, nil)
+func CountWhile0[T any](c Combinator[T]) func(string) (string, int, error) {
+	return countWhileN(c, 0)
+}
+
+func countWhileN[T any](c Combinator[T], n uint) func(string) (string, int, error) {
+	return func(s string) (string, int, error) {
+		var count uint
+		var err error
+
+		rem := s
+		for {
+			var tmpRem string
+			if tmpRem, _, err = c(rem); err != nil {
+				break
+			}
+			count++
+
+			if len(tmpRem) == len(rem) {
+				break
+			}
+			rem = tmpRem
+		}
+
+		if count < n {
+			return s, 0, RangedParserError{
+				Err:  err,
+				Exec: RangeExecution(count, n),
+				Type: "count_while",
+			}
+		}
+
+		return rem, int(count), nil
+	}
+}
+
+// ManyOf will scan the input text, and it must match the [Combinator] at least
+// once. Unlike [Many], the result of each match is collected into a slice of
+// its own type, rather than being flattened into a string slice. This allows
+// combinators of any type to be repeated. It is the equivalent of calling
+// [ManyOfN] with an argument of 1.
+//
+//	chomp.ManyOf(
+//		chomp.Combinator[int](chomp.Map(
+//			chomp.Suffixed(chomp.While(chomp.IsDigit), chomp.Opt(chomp.Tag(","))),
+//			func(in string) int { n, _ := strconv.Atoi(in); return n },
+//		)))("1,2,3")
+//	// ("", []int{1, 2, 3}, nil)
+func ManyOf[T any](c Combinator[T]) Combinator[[]T] {
+	return ManyOfN(c, 1)
+}
+
+// ManyOfN will scan the input text and match the [Combinator] a minimum number
+// of times. This [Combinator] is greedy and will continuously execute until
+// the first failed match. Unlike [ManyN], the result of each match is collected
+// into a slice of its own type.
+//
+//	chomp.ManyOfN(chomp.Combinator[bool](chomp.Map(
+//		chomp.Tag("y"),
+//		func(in string) bool { return true },
+//	)), 0)("nyy")
+//	// ("nyy", nil, nil)
+func ManyOfN[T any](c Combinator[T], n uint) Combinator[[]T] {
+	return func(s string) (string, []T, error) {
+		var ext []T
+		var err error
+		var count uint
+
+		rem := s
+		for {
+			var out T
+			var tmpRem string
+
+			if tmpRem, out, err = c(rem); err != nil {
+				break
+			}
+			rem = tmpRem
+			ext = append(ext, out)
+			count++
+		}
+
+		if count < n {
+			return rem, nil, RangedParserError{
+				Err:  err,
+				Exec: RangeExecution(count, n),
+				Type: "many_of_n",
+			}
+		}
+
+		return rem, ext, nil
+	}
+}
+
+// Preceded will scan the input text for a defined prefix and discard it
 // before matching the remaining text against the [Combinator]. Both
-// combinators must match.
+// combinators must match. The prefix can be of any type.
 //
-//	chomp.Prefixed(
-//		chomp.Tag("Hello"),
-//		chomp.Tag(`"`))(`"Hello, World!"`)
-//	// (`, World!"`, "Hello", nil)
-func Prefixed(c, pre Combinator[string]) Combinator[string] {
-	return func(s string) (string, string, error) {
+//	chomp.Preceded(
+//		chomp.Many(chomp.OneOf("#")),
+//		chomp.Until("!"))("## Hello, World!")
+//	// ("!", " Hello, World", nil)
+func Preceded[T, U any](pre Combinator[T], c Combinator[U]) Combinator[U] {
+	return func(s string) (string, U, error) {
+		var out U
+
 		rem, _, err := pre(s)
 		if err != nil {
-			return rem, "", err
+			return rem, out, err
 		}
 
 		return c(rem)
 	}
 }
 
-// Suffixed will scan the input text against the [Combinator] before matching a
-// suffix and discarding it. Both combinators must match.
+// Terminated will scan the input text against the [Combinator] before matching
+// a suffix and discarding it. Both combinators must match. The suffix can be
+// of any type.
 //
-//	chomp.Suffixed(
+//	chomp.Terminated(
 //		chomp.Tag("Hello"),
-//		chomp.Tag(", "))("Hello, World!")
+//		chomp.Many(chomp.OneOf(", ")))("Hello, World!")
 //	// ("World!", "Hello", nil)
-func Suffixed(c, suf Combinator[string]) Combinator[string] {
-	return func(s string) (string, string, error) {
+func Terminated[T, U any](c Combinator[T], post Combinator[U]) Combinator[T] {
+	return func(s string) (string, T, error) {
+		var out T
+
 		rem, ext, err := c(s)
 		if err != nil {
-			return rem, "", err
+			return rem, out, err
 		}
 
-		rem, _, err = suf(rem)
+		rem, _, err = post(rem)
 		if err != nil {
-			return rem, "", err
+			return rem, out, err
 		}
 
 		return rem, ext, nil
 	}
 }
+
+// Prefixed will scan the input text for a defined prefix and discard it
+// before matching the remaining text against the [Combinator]. Both
+// combinators must match. It is retained for backwards compatibility
+// and is the equivalent of calling [Preceded].
+//
+//	chomp.Prefixed(
+//		chomp.Tag("Hello"),
+//		chomp.Tag(`"`))(`"Hello, World!"`)
+//	// (`, World!"`, "Hello", nil)
+func Prefixed(c, pre Combinator[string]) Combinator[string] {
+	return Preceded(pre, c)
+}
+
+// Suffixed will scan the input text against the [Combinator] before matching a
+// suffix and discarding it. Both combinators must match. It is retained for
+// backwards compatibility and is the equivalent of calling [Terminated].
+//
+//	chomp.Suffixed(
+//		chomp.Tag("Hello"),
+//		chomp.Tag(", "))("Hello, World!")
+//	// ("World!", "Hello", nil)
+func Suffixed(c, suf Combinator[string]) Combinator[string] {
+	return Terminated(c, suf)
+}
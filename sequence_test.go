@@ -1,6 +1,9 @@
 package chomp_test
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/purpleclay/chomp"
@@ -58,6 +61,151 @@ func TestRepeatRange(t *testing.T) {
 	assert.Equal(t, "Joker", ext[2])
 }
 
+func TestRepeatTill(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   []string
+	}{
+		{
+			name:  "MinimumOnly",
+			input: "1,2,;3",
+			rem:   "3",
+			ext:   []string{"1", "2"},
+		},
+		{
+			name:  "BeyondMinimum",
+			input: "1,2,3,4,;",
+			rem:   "",
+			ext:   []string{"1", "2", "3", "4"},
+		},
+		{
+			name:  "EndMatchesWithinMinimum",
+			input: "1,;,2,;",
+			rem:   "",
+			ext:   []string{"1", ";", "2"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.RepeatTill(
+				chomp.Suffixed(chomp.Not(","), chomp.Tag(",")), chomp.Tag(";"), 2)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestRepeatTillError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "BelowMinimum",
+			input: "1,;",
+			err:   "(repeat_till) parser failed [count: 1 min: 2]",
+		},
+		{
+			name:  "NoEnd",
+			input: "1,2,3,",
+			err:   "(repeat_till) parser failed [count: 3 min: 2]. (tag) combinator failed to parse text '' with input ';'",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.RepeatTill(
+				chomp.Suffixed(chomp.While(chomp.IsDigit), chomp.Tag(",")), chomp.Tag(";"), 2)(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestRepeatTillZeroWidth(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.RepeatTill(chomp.Opt(chomp.Tag("a")), chomp.Tag(";"), 0)("b;")
+
+	require.Error(t, err)
+}
+
+func TestSepEndList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   []string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			rem:   "",
+			ext:   nil,
+		},
+		{
+			name:  "SingleElement",
+			input: "a",
+			rem:   "",
+			ext:   []string{"a"},
+		},
+		{
+			name:  "TrailingSeparator",
+			input: "a,b,c,",
+			rem:   "",
+			ext:   []string{"a", "b", "c"},
+		},
+		{
+			name:  "NoTrailingSeparator",
+			input: "a,b,c;",
+			rem:   ";",
+			ext:   []string{"a", "b", "c"},
+		},
+		{
+			name:  "OnlySingleTrailingSeparator",
+			input: "a,b,,c",
+			rem:   ",c",
+			ext:   []string{"a", "b"},
+		},
+		{
+			name:  "NoElements",
+			input: ",a",
+			rem:   ",a",
+			ext:   nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.SepEndList(chomp.Tag(","), chomp.While(chomp.IsLetter))(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
 func TestDelimited(t *testing.T) {
 	t.Parallel()
 
@@ -191,3 +339,703 @@ func TestSuffixed(t *testing.T) {
 	assert.Equal(t, " World", rem)
 	assert.Equal(t, "Hello", ext)
 }
+
+func TestAlt(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Alt(chomp.Tag("Light"), chomp.Tag("Dark"))("Dark Knight")
+
+	require.NoError(t, err)
+	assert.Equal(t, " Knight", rem)
+	assert.Equal(t, "Dark", ext)
+}
+
+func TestAltAggregatesErrors(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.Alt(
+		chomp.Tag("Light"),
+		chomp.Flatten(chomp.Pair(chomp.Tag("Dark "), chomp.Tag("Night"))),
+		chomp.Tag("Bright"))("Dark Knight")
+
+	require.Error(t, err)
+	assert.Equal(t, "Dark Knight", rem)
+
+	var altErr chomp.AltError
+	require.ErrorAs(t, err, &altErr)
+	require.Len(t, altErr.Errs, 3)
+	assert.ErrorContains(t, altErr.Errs[0], "(flatten) parser failed")
+	assert.ErrorContains(t, altErr.Errs[1], "with input 'Light'")
+	assert.ErrorContains(t, altErr.Errs[2], "with input 'Bright'")
+}
+
+func TestAltErrorUnwrapsBranches(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.Alt(chomp.Tag("Light"), chomp.Tag("Bright"))("Dark Knight")
+	require.Error(t, err)
+
+	var parseErr chomp.CombinatorParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, "tag", parseErr.Type)
+}
+
+func TestFirstStopsOnFatalError(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.First(
+		chomp.Pair(chomp.Tag("let "), chomp.Cut(chomp.Tag("x ="))),
+		chomp.Pair(chomp.Tag("let"), chomp.Tag(" y")))("let y = 1")
+
+	require.Error(t, err)
+	assert.Equal(t, "let y = 1", rem)
+
+	var fatal chomp.FatalError
+	require.ErrorAs(t, err, &fatal)
+	assert.ErrorContains(t, err, "with input 'x ='")
+}
+
+func TestAltStopsOnFatalError(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.Alt(
+		chomp.S(chomp.Tag("var")),
+		chomp.Pair(chomp.Tag("let "), chomp.Cut(chomp.Tag("x ="))),
+		chomp.Pair(chomp.Tag("let"), chomp.Tag(" y")))("let y = 1")
+
+	require.Error(t, err)
+	assert.Equal(t, "let y = 1", rem)
+
+	var fatal chomp.FatalError
+	require.ErrorAs(t, err, &fatal)
+
+	var altErr chomp.AltError
+	assert.False(t, errors.As(err, &altErr))
+}
+
+func TestPermutation(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Permutation(
+		chomp.Tag("host;"),
+		chomp.Tag("port;"),
+		chomp.Tag("user;"))("user;host;port;rest")
+
+	require.NoError(t, err)
+	assert.Equal(t, "rest", rem)
+	assert.Equal(t, []string{"host;", "port;", "user;"}, ext)
+}
+
+func TestPermutationMissing(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.Permutation(
+		chomp.Tag("host;"),
+		chomp.Tag("port;"),
+		chomp.Tag("user;"))("port;rest")
+
+	require.Error(t, err)
+	assert.EqualError(t, err, "(permutation) parser failed. combinators at index [0 2] did not match text 'rest'")
+}
+
+func TestEscapedString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  string
+		quote  rune
+		escape rune
+		rem    string
+		ext    string
+	}{
+		{
+			name:   "EscapedQuote",
+			input:  `"he said \"hi\"" to me`,
+			quote:  '"',
+			escape: '\\',
+			rem:    " to me",
+			ext:    `he said \"hi\"`,
+		},
+		{
+			name:   "EscapedEscape",
+			input:  `'C:\\'`,
+			quote:  '\'',
+			escape: '\\',
+			rem:    "",
+			ext:    `C:\\`,
+		},
+		{
+			name:   "Unicode",
+			input:  "┃こんにちは\\┃おはよう┃、",
+			quote:  '┃',
+			escape: '\\',
+			rem:    "、",
+			ext:    "こんにちは\\┃おはよう",
+		},
+		{
+			name:   "Empty",
+			input:  `""`,
+			quote:  '"',
+			escape: '\\',
+			rem:    "",
+			ext:    "",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.EscapedString(tt.quote, tt.escape)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestEscapedStringInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "MissingClosingQuote",
+			input: `"Hello, World!`,
+		},
+		{
+			name:  "EscapedClosingQuote",
+			input: `"Hello, World!\"`,
+		},
+		{
+			name:  "TrailingEscape",
+			input: `"Hello, World!\`,
+		},
+		{
+			name:  "MissingOpeningQuote",
+			input: `Hello, World!"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.EscapedString('"', '\\')(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestEscapedTransform(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.EscapedTransform('"', '\\', decodeEscape)(`"he said \"hi\"\n\tこんにちは\\" to me`)
+
+	require.NoError(t, err)
+	assert.Equal(t, " to me", rem)
+	assert.Equal(t, "he said \"hi\"\n\tこんにちは\\", ext)
+}
+
+func TestEscapedTransformUnknownEscape(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.EscapedTransform('"', '\\', decodeEscape)(`"Hello, \World!"`)
+
+	require.Error(t, err)
+	assert.Equal(t, `"Hello, \World!"`, rem)
+	assert.EqualError(t, err, "(escaped_transform) parser failed. unknown escape sequence: \\W")
+}
+
+func decodeEscape(r rune) (string, error) {
+	switch r {
+	case 'n':
+		return "\n", nil
+	case 't':
+		return "\t", nil
+	case '"', '\\':
+		return string(r), nil
+	}
+
+	return "", fmt.Errorf("unknown escape sequence: \\%c", r)
+}
+
+func TestFirstMappedResults(t *testing.T) {
+	t.Parallel()
+
+	type Light struct{ Lumens int }
+
+	rem, ext, err := chomp.First(
+		chomp.Combinator[Light](chomp.Map(chomp.Tag("dim"), func(string) Light { return Light{Lumens: 10} })),
+		chomp.Combinator[Light](chomp.Map(chomp.Tag("bright"), func(string) Light { return Light{Lumens: 800} })),
+	)("bright lights")
+
+	require.NoError(t, err)
+	assert.Equal(t, " lights", rem)
+	assert.Equal(t, Light{Lumens: 800}, ext)
+}
+
+func TestManyOf(t *testing.T) {
+	t.Parallel()
+
+	number := chomp.Combinator[int](chomp.Map(
+		chomp.Suffixed(chomp.While(chomp.IsDigit), chomp.Opt(chomp.Tag(","))),
+		func(in string) int {
+			n, _ := strconv.Atoi(in)
+			return n
+		},
+	))
+
+	rem, ext, err := chomp.ManyOf(number)("1,22,333 and more")
+
+	require.NoError(t, err)
+	assert.Equal(t, " and more", rem)
+	assert.Equal(t, []int{1, 22, 333}, ext)
+}
+
+func TestManyOfN(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.ManyOfN(chomp.Tag("ha"), 3)("haha!")
+
+	require.Error(t, err)
+	assert.Equal(t, "!", rem)
+	assert.EqualError(t, err, "(many_of_n) parser failed [count: 2 min: 3]. (tag) combinator failed to parse text '!' with input 'ha'")
+}
+
+func TestPreceded(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Preceded(chomp.Many(chomp.OneOf("#")), chomp.Until("!"))("## Hello, World!")
+
+	require.NoError(t, err)
+	assert.Equal(t, "!", rem)
+	assert.Equal(t, " Hello, World", ext)
+}
+
+func TestTerminated(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Terminated(chomp.Tag("Hello"), chomp.Many(chomp.OneOf(", ")))("Hello, World!")
+
+	require.NoError(t, err)
+	assert.Equal(t, "World!", rem)
+	assert.Equal(t, "Hello", ext)
+}
+
+func TestCountWhile(t *testing.T) {
+	t.Parallel()
+
+	rem, count, err := chomp.CountWhile(chomp.Tag("#"))("### Heading")
+
+	require.NoError(t, err)
+	assert.Equal(t, " Heading", rem)
+	assert.Equal(t, 3, count)
+}
+
+func TestCountWhileNoMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, count, err := chomp.CountWhile(chomp.Tag("#"))("Heading")
+
+	require.EqualError(t, err, "(count_while) parser failed [count: 0 min: 1]. (tag) combinator failed to parse text 'Heading' with input '#'")
+	assert.Equal(t, "Heading", rem)
+	assert.Equal(t, 0, count)
+}
+
+func TestCountWhileStopsOnZeroWidthMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, count, err := chomp.CountWhile(chomp.WhileN(chomp.IsDigit, 0))("Hello")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", rem)
+	assert.Equal(t, 1, count)
+}
+
+func TestCountWhile0(t *testing.T) {
+	t.Parallel()
+
+	rem, count, err := chomp.CountWhile0(chomp.Tag("#"))("Heading")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Heading", rem)
+	assert.Equal(t, 0, count)
+}
+
+func TestBalanced(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Nested",
+			input: "(a(b)c) + d",
+			rem:   " + d",
+			ext:   "a(b)c",
+		},
+		{
+			name:  "DeeplyNested",
+			input: "(define (sq x) (* x x))",
+			rem:   "",
+			ext:   "define (sq x) (* x x)",
+		},
+		{
+			name:  "Empty",
+			input: "()()",
+			rem:   "()",
+			ext:   "",
+		},
+		{
+			name:  "Unicode",
+			input: "「こん「に」ちは」!",
+			rem:   "!",
+			ext:   "こん「に」ちは",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			open, close := '(', ')'
+			if tt.name == "Unicode" {
+				open, close = '「', '」'
+			}
+			rem, ext, err := chomp.Balanced(open, close)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestBalancedUnbalanced(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "MissingClose",
+			input: "(a(b)c",
+		},
+		{
+			name:  "MissingOpen",
+			input: "a(b)c)",
+		},
+		{
+			name:  "CloseFirst",
+			input: ")(",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.Balanced('(', ')')(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestBalancedEscaped(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.BalancedEscaped('(', ')', '\\')(`(a\)(b\(\\)c) + d`)
+
+	require.NoError(t, err)
+	assert.Equal(t, " + d", rem)
+	assert.Equal(t, `a\)(b\(\\)c`, ext)
+}
+
+func TestSurrounded(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		delim string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Single",
+			delim: "*",
+			input: "*Hello*, World!",
+			rem:   ", World!",
+			ext:   "Hello",
+		},
+		{
+			name:  "Multiple",
+			delim: "**",
+			input: "**Hello**, World!",
+			rem:   ", World!",
+			ext:   "Hello",
+		},
+		{
+			name:  "Unicode",
+			delim: "〜",
+			input: "〜こんにちは〜!",
+			rem:   "!",
+			ext:   "こんにちは",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.Surrounded(tt.delim, chomp.Until(tt.delim))(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestSurroundedMissingDelimiter(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := chomp.Surrounded("_", chomp.While(chomp.IsLetter))("_Hello, World!")
+
+	require.EqualError(t, err, "(delimited) parser failed. (tag) combinator failed to parse text ', World!' with input '_'")
+}
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Between(chomp.Spaces0(), chomp.Tag("Hello"))(" \tHello  \n, World!")
+
+	require.NoError(t, err)
+	assert.Equal(t, "\n, World!", rem)
+	assert.Equal(t, "Hello", ext)
+}
+
+func TestBetweenOptionalWhitespace(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Between(chomp.Tag(" "), chomp.Tag("Hello"))("Hello, World!")
+
+	require.NoError(t, err)
+	assert.Equal(t, ", World!", rem)
+	assert.Equal(t, "Hello", ext)
+}
+
+func TestBetweenTokens(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.All(
+		chomp.Between(chomp.Spaces0(), chomp.Identifier()),
+		chomp.Between(chomp.Spaces0(), chomp.Tag("=")),
+		chomp.Between(chomp.Spaces0(), chomp.While(chomp.IsDigit)),
+	)("  answer =   42 ;")
+
+	require.NoError(t, err)
+	assert.Equal(t, ";", rem)
+	assert.Equal(t, []string{"answer", "=", "42"}, ext)
+}
+
+func TestBetweenNoMatch(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.Between(chomp.Spaces0(), chomp.Tag("Goodbye"))("  Hello, World!")
+
+	require.Error(t, err)
+	assert.Equal(t, "  Hello, World!", rem)
+	assert.EqualError(t, err, "(between) parser failed. (tag) combinator failed to parse text 'Hello, World!' with input 'Goodbye'")
+}
+
+func integer() chomp.MappedCombinator[int64, string] {
+	return chomp.FirstMapped(
+		chomp.MapErr(
+			chomp.Preceded(chomp.Tag("0x"), chomp.While(chomp.IsAlphanumeric)),
+			func(in string) (int64, error) { return strconv.ParseInt(in, 16, 64) }),
+		chomp.MapErr(
+			chomp.While(chomp.IsDigit),
+			func(in string) (int64, error) { return strconv.ParseInt(in, 10, 64) }))
+}
+
+func TestFirstMapped(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		num   int64
+	}{
+		{name: "Hexadecimal", input: "0x1F, 42", rem: ", 42", num: 31},
+		{name: "Decimal", input: "42, 0x1F", rem: ", 0x1F", num: 42},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, num, err := integer()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.num, num)
+		})
+	}
+}
+
+func TestFirstMappedAggregatesErrors(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := integer()("Ox1F")
+
+	require.Error(t, err)
+	assert.Equal(t, "Ox1F", rem)
+
+	var altErr chomp.AltError
+	require.ErrorAs(t, err, &altErr)
+	require.Len(t, altErr.Errs, 2)
+	assert.ErrorContains(t, err, "with input '0x'")
+	assert.ErrorContains(t, err, "(is_digit) combinator failed")
+}
+
+func parenthesize(left, right, op string) string {
+	return "(" + left + op + right + ")"
+}
+
+func TestChainLeft(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "SingleOperand",
+			input: "42",
+			rem:   "",
+			ext:   "42",
+		},
+		{
+			name:  "LeftAssociative",
+			input: "1+2-3+4 rest",
+			rem:   " rest",
+			ext:   "(((1+2)-3)+4)",
+		},
+		{
+			name:  "TrailingOperator",
+			input: "1+2+",
+			rem:   "+",
+			ext:   "(1+2)",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.ChainLeft(
+				chomp.While(chomp.IsDigit),
+				chomp.OneOf("+-"),
+				parenthesize)(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestChainLeftCalculator(t *testing.T) {
+	t.Parallel()
+
+	eval := func(left, right, op string) string {
+		l, _ := strconv.Atoi(left)
+		r, _ := strconv.Atoi(right)
+
+		switch op {
+		case "+":
+			return strconv.Itoa(l + r)
+		case "-":
+			return strconv.Itoa(l - r)
+		case "*":
+			return strconv.Itoa(l * r)
+		default:
+			return strconv.Itoa(l / r)
+		}
+	}
+
+	token := func(c chomp.Combinator[string]) chomp.Combinator[string] {
+		return chomp.Between(chomp.Spaces0(), c)
+	}
+
+	var expr chomp.Combinator[string]
+	factor := chomp.First(
+		token(chomp.While(chomp.IsDigit)),
+		chomp.Delimited(
+			token(chomp.Tag("(")),
+			chomp.Lazy(func() chomp.Combinator[string] { return expr }),
+			token(chomp.Tag(")"))))
+	term := chomp.ChainLeft(factor, token(chomp.OneOf("*/")), eval)
+	expr = chomp.ChainLeft(term, token(chomp.OneOf("+-")), eval)
+
+	rem, ext, err := expr("2 * (3 + 4) - 10 / 5 - 1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "", rem)
+	assert.Equal(t, "11", ext)
+}
+
+func TestChainLeftNoOperand(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.ChainLeft(
+		chomp.While(chomp.IsDigit),
+		chomp.OneOf("+-"),
+		parenthesize)("+1")
+
+	require.Error(t, err)
+	assert.Equal(t, "+1", rem)
+	assert.ErrorContains(t, err, "(chain_left) parser failed")
+}
+
+func TestChainRight(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.ChainRight(
+		chomp.While(chomp.IsDigit),
+		chomp.Tag("^"),
+		parenthesize)("2^3^2^ rest")
+
+	require.NoError(t, err)
+	assert.Equal(t, "^ rest", rem)
+	assert.Equal(t, "(2^(3^2))", ext)
+}
+
+func TestChainRightNoOperand(t *testing.T) {
+	t.Parallel()
+
+	rem, _, err := chomp.ChainRight(
+		chomp.While(chomp.IsDigit),
+		chomp.Tag("^"),
+		parenthesize)("^2")
+
+	require.Error(t, err)
+	assert.Equal(t, "^2", rem)
+	assert.ErrorContains(t, err, "(chain_right) parser failed")
+}
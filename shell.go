@@ -0,0 +1,264 @@
+package chomp
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ShellVarParts contains the individual parts of a parsed shell
+// variable expansion.
+type ShellVarParts struct {
+	// Name of the variable.
+	Name string
+
+	// Op is the parameter expansion operator, if any. Supported operators
+	// are: ":-", "-", ":=", "=", ":+", "+", ":?" and "?".
+	Op string
+
+	// Default contains the word following the operator. Depending on the
+	// operator, this is either a default or alternate value, or an error
+	// message.
+	Default string
+}
+
+type isShellVarName struct{}
+
+func (isShellVarName) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_'
+}
+
+func (isShellVarName) String() string {
+	return "is_shell_var_name"
+}
+
+// ShellVar will parse a shell variable expansion. Both the simple $VAR and
+// braced ${VAR} forms are supported, along with the parameter expansion
+// operators ":-", "-", ":=", "=", ":+", "+", ":?" and "?". A variable name
+// must start with an ASCII letter or underscore, followed by any number of
+// ASCII letters, digits or underscores. Braces within the word following an
+// operator must be balanced.
+//
+//	chomp.ShellVar()("${HOME:-/root}/.config")
+//	// ("/.config", chomp.ShellVarParts{Name: "HOME", Op: ":-", Default: "/root"}, nil)
+func ShellVar() Combinator[ShellVarParts] {
+	return Combinator[ShellVarParts](Map(
+		Pair(
+			Tag("$"),
+			First(
+				Delimited(Tag("{"), Pair(shellVarName(), Opt(Pair(shellVarOp(), shellVarWord()))), Tag("}")),
+				S(shellVarName()),
+			)),
+		func(in []string) ShellVarParts {
+			parts := ShellVarParts{Name: in[1]}
+			if len(in) == 4 {
+				parts.Op = in[2]
+				parts.Default = in[3]
+			}
+			return parts
+		},
+	))
+}
+
+func shellVarName() Combinator[string] {
+	return func(s string) (string, string, error) {
+		if s == "" || (s[0] >= '0' && s[0] <= '9') {
+			return s, "", CombinatorParseError{Text: s, Type: "shell_var_name"}
+		}
+
+		return While(isShellVarName{})(s)
+	}
+}
+
+func shellVarOp() Combinator[string] {
+	return First(
+		Tag(":-"), Tag(":="), Tag(":+"), Tag(":?"),
+		Tag("-"), Tag("="), Tag("+"), Tag("?"),
+	)
+}
+
+func shellVarWord() Combinator[string] {
+	return func(s string) (string, string, error) {
+		depth := 0
+		for i, c := range s {
+			switch c {
+			case '{':
+				depth++
+			case '}':
+				if depth == 0 {
+					return s[i:], s[:i], nil
+				}
+				depth--
+			}
+		}
+
+		return s, "", CombinatorParseError{Input: "}", Text: s, Type: "shell_var_word"}
+	}
+}
+
+var ansiCEscapes = map[byte]string{
+	'a': "\a", 'b': "\b", 'e': "\x1b", 'E': "\x1b", 'f': "\f", 'n': "\n",
+	'r': "\r", 't': "\t", 'v': "\v", '\\': "\\", '\'': "'", '"': "\"", '?': "?",
+}
+
+// ANSICQuote will parse a bash ANSI-C quoted string, $'...', returning its
+// decoded value. The following escape sequences are supported:
+//   - \a, \b, \e, \E, \f, \n, \r, \t, \v, \\, \', \" and \?
+//   - \nnn, a byte with an octal value of one to three digits
+//   - \xHH, a byte with a hexadecimal value of one or two digits
+//   - \uHHHH, a unicode character with a hexadecimal value of one to four digits
+//   - \UHHHHHHHH, a unicode character with a hexadecimal value of one to eight digits
+//   - \cx, a control-x character
+//
+// Mirroring bash, any other escape sequence is left untouched.
+//
+//	chomp.ANSICQuote()(`$'Hello,\tWorld!\n' > out.txt`)
+//	// (" > out.txt", "Hello,\tWorld!\n", nil)
+func ANSICQuote() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, _, err := Tag("$'")(s)
+		if err != nil {
+			return s, "", err
+		}
+
+		var buf strings.Builder
+		for rem != "" {
+			switch rem[0] {
+			case '\'':
+				return rem[1:], buf.String(), nil
+			case '\\':
+				var decoded string
+				if rem, decoded, err = ansiCEscape(rem); err != nil {
+					return s, "", err
+				}
+				buf.WriteString(decoded)
+			default:
+				_, size := utf8.DecodeRuneInString(rem)
+				buf.WriteString(rem[:size])
+				rem = rem[size:]
+			}
+		}
+
+		return s, "", CombinatorParseError{Input: "'", Text: s, Type: "ansi_c_quote"}
+	}
+}
+
+func ansiCEscape(s string) (string, string, error) {
+	if len(s) < 2 {
+		return s, "", CombinatorParseError{Text: s, Type: "ansi_c_escape"}
+	}
+
+	if decoded, ok := ansiCEscapes[s[1]]; ok {
+		return s[2:], decoded, nil
+	}
+
+	isHex := func(r rune) bool { return isHexDigit{}.Match(r) }
+	isOctal := func(r rune) bool { return r >= '0' && r <= '7' }
+
+	switch s[1] {
+	case 'x', 'u', 'U':
+		max := map[byte]uint{'x': 2, 'u': 4, 'U': 8}[s[1]]
+		rem, digits, err := TakeWhileMN(1, max, isHex)(s[2:])
+		if err != nil {
+			// bash leaves an escape without any digits untouched
+			return s[2:], s[:2], nil
+		}
+
+		n, _ := strconv.ParseUint(digits, 16, 32)
+		if s[1] == 'x' {
+			return rem, string([]byte{byte(n)}), nil
+		}
+		return rem, string(rune(n)), nil
+	case 'c':
+		if len(s) < 3 {
+			return s, "", CombinatorParseError{Text: s, Type: "ansi_c_escape"}
+		}
+		return s[3:], string([]byte{s[2] & 0x1f}), nil
+	}
+
+	if rem, digits, err := TakeWhileMN(1, 3, isOctal)(s[1:]); err == nil {
+		n, _ := strconv.ParseUint(digits, 8, 16)
+		return rem, string([]byte{byte(n)}), nil
+	}
+
+	return s[2:], s[:2], nil
+}
+
+// HereString will parse a bash here-string, <<< word, returning the word
+// after any quote removal. A word can be made up of any combination of
+// unquoted, single-quoted ('...'), double-quoted ("...") and ANSI-C quoted
+// ($'...') text, and ends at the first unquoted whitespace or shell
+// metacharacter (;, &, |, <, >, ( or )).
+//
+//	chomp.HereString()(`<<< "Hello, $USER" | cat`)
+//	// (" | cat", "Hello, $USER", nil)
+func HereString() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, word, err := Preceded(Terminated(Tag("<<<"), WhileN(isBlank{}, 0)), shellWord())(s)
+		if err != nil {
+			return s, "", ParserError{Err: err, Type: "here_string"}
+		}
+
+		return rem, word, nil
+	}
+}
+
+func shellWord() Combinator[string] {
+	return func(s string) (string, string, error) {
+		var buf strings.Builder
+
+		rem := s
+		for rem != "" && !strings.ContainsRune(" \t\r\n;&|<>()", rune(rem[0])) {
+			var seg string
+			var err error
+
+			switch {
+			case strings.HasPrefix(rem, "$'"):
+				rem, seg, err = ANSICQuote()(rem)
+			case rem[0] == '\'':
+				rem, seg, err = QuoteSingle()(rem)
+			case rem[0] == '"':
+				rem, seg, err = shellDoubleQuote(rem)
+			case rem[0] == '\\':
+				if len(rem) < 2 {
+					err = CombinatorParseError{Text: rem, Type: "shell_word"}
+					break
+				}
+				_, size := utf8.DecodeRuneInString(rem[1:])
+				seg, rem = rem[1:1+size], rem[1+size:]
+			default:
+				_, size := utf8.DecodeRuneInString(rem)
+				seg, rem = rem[:size], rem[size:]
+			}
+
+			if err != nil {
+				return s, "", err
+			}
+			buf.WriteString(seg)
+		}
+
+		if rem == s {
+			return s, "", CombinatorParseError{Text: s, Type: "shell_word"}
+		}
+
+		return rem, buf.String(), nil
+	}
+}
+
+func shellDoubleQuote(s string) (string, string, error) {
+	rem, ext, err := EscapedString('"', '\\')(s)
+	if err != nil {
+		return s, "", err
+	}
+
+	// Within double quotes, a backslash only escapes a ", \, $, ` or newline
+	var buf strings.Builder
+	for i := 0; i < len(ext); i++ {
+		if ext[i] == '\\' && i+1 < len(ext) && strings.ContainsRune("\"\\$`\n", rune(ext[i+1])) {
+			i++
+		}
+		buf.WriteByte(ext[i])
+	}
+
+	return rem, buf.String(), nil
+}
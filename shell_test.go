@@ -0,0 +1,316 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellVar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		parts chomp.ShellVarParts
+	}{
+		{
+			name:  "Simple",
+			input: "$HOME/.config",
+			rem:   "/.config",
+			parts: chomp.ShellVarParts{Name: "HOME"},
+		},
+		{
+			name:  "Braced",
+			input: "${GO_VERSION}-alpine",
+			rem:   "-alpine",
+			parts: chomp.ShellVarParts{Name: "GO_VERSION"},
+		},
+		{
+			name:  "DefaultIfUnsetOrNull",
+			input: "${HOME:-/root}/.config",
+			rem:   "/.config",
+			parts: chomp.ShellVarParts{Name: "HOME", Op: ":-", Default: "/root"},
+		},
+		{
+			name:  "AssignIfUnsetOrNull",
+			input: "${EDITOR:=vim}",
+			rem:   "",
+			parts: chomp.ShellVarParts{Name: "EDITOR", Op: ":=", Default: "vim"},
+		},
+		{
+			name:  "AlternateIfSet",
+			input: "${DEBUG:+--verbose}",
+			rem:   "",
+			parts: chomp.ShellVarParts{Name: "DEBUG", Op: ":+", Default: "--verbose"},
+		},
+		{
+			name:  "ErrorIfUnset",
+			input: "${TOKEN?token is required}",
+			rem:   "",
+			parts: chomp.ShellVarParts{Name: "TOKEN", Op: "?", Default: "token is required"},
+		},
+		{
+			name:  "DefaultIfUnset",
+			input: "${PORT-8080}",
+			rem:   "",
+			parts: chomp.ShellVarParts{Name: "PORT", Op: "-", Default: "8080"},
+		},
+		{
+			name:  "NestedExpansion",
+			input: "${CONFIG:-${HOME}/.config} exists",
+			rem:   " exists",
+			parts: chomp.ShellVarParts{Name: "CONFIG", Op: ":-", Default: "${HOME}/.config"},
+		},
+		{
+			name:  "EmptyDefault",
+			input: "${NAME:-}",
+			rem:   "",
+			parts: chomp.ShellVarParts{Name: "NAME", Op: ":-"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, parts, err := chomp.ShellVar()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.parts, parts)
+		})
+	}
+}
+
+func TestShellVarInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "LeadingDigit",
+			input: "$1PATH",
+		},
+		{
+			name:  "Unterminated",
+			input: "${HOME:-/root",
+		},
+		{
+			name:  "UnsupportedOperator",
+			input: "${HOME#/}",
+		},
+		{
+			name:  "NoDollar",
+			input: "HOME",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, _, err := chomp.ShellVar()(tt.input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestANSICQuote(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "ControlEscapes",
+			input:    `$'Hello,\tWorld!\n'`,
+			expected: "Hello,\tWorld!\n",
+		},
+		{
+			name:     "QuoteEscapes",
+			input:    `$'it\'s a \"test\"\\'`,
+			expected: `it's a "test"\`,
+		},
+		{
+			name:     "Escape",
+			input:    `$'\e[1mbold\E[0m'`,
+			expected: "\x1b[1mbold\x1b[0m",
+		},
+		{
+			name:     "Hex",
+			input:    `$'\x41\x4a\x7'`,
+			expected: "AJ\x07",
+		},
+		{
+			name:     "Octal",
+			input:    `$'\101\7\0123'`,
+			expected: "A\x07\n3",
+		},
+		{
+			name:     "Unicode",
+			input:    `$'こん\U0001F600'`,
+			expected: "こん😀",
+		},
+		{
+			name:     "Control",
+			input:    `$'\cA\cz'`,
+			expected: "\x01\x1a",
+		},
+		{
+			name:     "UnknownEscape",
+			input:    `$'\q\x'`,
+			expected: `\q\x`,
+		},
+		{
+			name:     "Empty",
+			input:    `$''`,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.ANSICQuote()(tt.input + " > out.txt")
+
+			require.NoError(t, err)
+			assert.Equal(t, " > out.txt", rem)
+			assert.Equal(t, tt.expected, ext)
+		})
+	}
+}
+
+func TestANSICQuoteInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "SingleQuoted",
+			input: "'Hello'",
+		},
+		{
+			name:  "Unterminated",
+			input: `$'Hello\'`,
+		},
+		{
+			name:  "TrailingBackslash",
+			input: `$'Hello\`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.ANSICQuote()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestHereString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		rem      string
+		expected string
+	}{
+		{
+			name:     "Unquoted",
+			input:    "<<< hello | cat",
+			rem:      " | cat",
+			expected: "hello",
+		},
+		{
+			name:     "NoSpace",
+			input:    "<<<hello; echo done",
+			rem:      "; echo done",
+			expected: "hello",
+		},
+		{
+			name:     "DoubleQuoted",
+			input:    `<<< "Hello, \"$USER\" \n" > out.txt`,
+			rem:      " > out.txt",
+			expected: `Hello, "$USER" \n`,
+		},
+		{
+			name:     "SingleQuoted",
+			input:    `<<< 'Hello, World!'`,
+			expected: "Hello, World!",
+		},
+		{
+			name:     "ANSICQuoted",
+			input:    `<<< $'Hello,\tWorld!'`,
+			expected: "Hello,\tWorld!",
+		},
+		{
+			name:     "Concatenated",
+			input:    `<<< key='a value'"!"\ escaped` + "\n",
+			rem:      "\n",
+			expected: "key=a value! escaped",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ext, err := chomp.HereString()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.expected, ext)
+		})
+	}
+}
+
+func TestHereStringInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Heredoc",
+			input: "<< EOF",
+		},
+		{
+			name:  "MissingWord",
+			input: "<<< | cat",
+		},
+		{
+			name:  "UnterminatedQuote",
+			input: `<<< "Hello`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.HereString()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package chomp
+
+import "strings"
+
+// SPDXExpr is a license expression parsed from an SPDX license expression.
+type SPDXExpr interface {
+	// String returns the expression using SPDX license expression syntax.
+	String() string
+}
+
+// SPDXLicense is a single license, with an optional exception.
+type SPDXLicense struct {
+	// ID of the license, such as MIT or LicenseRef-Custom. A reference to
+	// an external document is included, DocumentRef-Spec:LicenseRef-Custom.
+	ID string
+
+	// OrLater is true if the license was suffixed by a '+', indicating
+	// that any later version of the license may be used.
+	OrLater bool
+
+	// Exception to the license, declared using the WITH operator, such as
+	// Classpath-exception-2.0.
+	Exception string
+}
+
+// SPDXAnd is the conjunction of two expressions, X AND Y.
+type SPDXAnd struct {
+	X, Y SPDXExpr
+}
+
+// SPDXOr is the disjunction of two expressions, X OR Y.
+type SPDXOr struct {
+	X, Y SPDXExpr
+}
+
+// String returns the license, along with any exception.
+func (e SPDXLicense) String() string {
+	var buf strings.Builder
+	buf.WriteString(e.ID)
+	if e.OrLater {
+		buf.WriteString("+")
+	}
+
+	if e.Exception != "" {
+		buf.WriteString(" WITH ")
+		buf.WriteString(e.Exception)
+	}
+	return buf.String()
+}
+
+// String returns the conjunction, parenthesizing any disjunctions.
+func (e SPDXAnd) String() string {
+	return spdxOperand(e.X) + " AND " + spdxOperand(e.Y)
+}
+
+// String returns the disjunction.
+func (e SPDXOr) String() string { return e.X.String() + " OR " + e.Y.String() }
+
+func spdxOperand(e SPDXExpr) string {
+	if _, ok := e.(SPDXOr); ok {
+		return "(" + e.String() + ")"
+	}
+	return e.String()
+}
+
+type isSPDXIDString struct{}
+
+func (isSPDXIDString) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '.'
+}
+
+func (isSPDXIDString) String() string {
+	return "is_spdx_idstring"
+}
+
+// SPDXExpression will parse an SPDX license expression into a tree. An expression
+// is made up of license identifiers combined using the operators WITH, AND and OR,
+// in order of precedence. Operators are case-sensitive. A license identifier can be
+// suffixed by a '+', and WITH must be followed by an exception identifier. Parentheses
+// can be used to group expressions.
+//
+//	chomp.SPDXExpression()("MIT OR (Apache-2.0 AND BSD-3-Clause)")
+//	// ("", chomp.SPDXOr{X: chomp.SPDXLicense{ID: "MIT"}, Y: chomp.SPDXAnd{...}}, nil)
+func SPDXExpression() Combinator[SPDXExpr] {
+	return func(s string) (string, SPDXExpr, error) {
+		rem, expr, err := spdxOr(s)
+		if err != nil {
+			return s, nil, ParserError{Err: err, Type: "spdx_expression"}
+		}
+
+		return rem, expr, nil
+	}
+}
+
+func spdxOperator(op string) Combinator[string] {
+	return Delimited(Spaces(), Tag(op), Spaces())
+}
+
+func spdxOr(s string) (string, SPDXExpr, error) {
+	rem, x, err := spdxAnd(s)
+	if err != nil {
+		return s, nil, err
+	}
+
+	for {
+		next, _, err := spdxOperator("OR")(rem)
+		if err != nil {
+			return rem, x, nil
+		}
+
+		var y SPDXExpr
+		if rem, y, err = spdxAnd(next); err != nil {
+			return s, nil, err
+		}
+		x = SPDXOr{X: x, Y: y}
+	}
+}
+
+func spdxAnd(s string) (string, SPDXExpr, error) {
+	rem, x, err := spdxTerm(s)
+	if err != nil {
+		return s, nil, err
+	}
+
+	for {
+		next, _, err := spdxOperator("AND")(rem)
+		if err != nil {
+			return rem, x, nil
+		}
+
+		var y SPDXExpr
+		if rem, y, err = spdxTerm(next); err != nil {
+			return s, nil, err
+		}
+		x = SPDXAnd{X: x, Y: y}
+	}
+}
+
+func spdxTerm(s string) (string, SPDXExpr, error) {
+	if rem, _, err := Terminated(Tag("("), Spaces0())(s); err == nil {
+		rem, x, err := spdxOr(rem)
+		if err != nil {
+			return s, nil, err
+		}
+
+		if rem, _, err = Preceded(Spaces0(), Tag(")"))(rem); err != nil {
+			return s, nil, err
+		}
+		return rem, x, nil
+	}
+
+	rem, id, err := While(isSPDXIDString{})(s)
+	if err != nil {
+		return s, nil, err
+	}
+
+	if strings.HasPrefix(id, "DocumentRef-") {
+		var ref string
+		if rem, ref, err = Preceded(Tag(":"), While(isSPDXIDString{}))(rem); err != nil {
+			return s, nil, err
+		}
+		id += ":" + ref
+	}
+
+	if id == "AND" || id == "OR" || id == "WITH" {
+		return s, nil, CombinatorParseError{Text: s, Type: "spdx_license"}
+	}
+
+	license := SPDXLicense{ID: id}
+	if next, _, err := Tag("+")(rem); err == nil {
+		rem = next
+		license.OrLater = true
+	}
+
+	if next, _, err := spdxOperator("WITH")(rem); err == nil {
+		if rem, license.Exception, err = While(isSPDXIDString{})(next); err != nil {
+			return s, nil, err
+		}
+	}
+
+	return rem, license, nil
+}
@@ -0,0 +1,121 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSPDXExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected chomp.SPDXExpr
+		str      string
+	}{
+		{
+			name:     "License",
+			input:    "MIT",
+			expected: chomp.SPDXLicense{ID: "MIT"},
+			str:      "MIT",
+		},
+		{
+			name:     "OrLaterWithException",
+			input:    "GPL-2.0+ WITH Classpath-exception-2.0",
+			expected: chomp.SPDXLicense{ID: "GPL-2.0", OrLater: true, Exception: "Classpath-exception-2.0"},
+			str:      "GPL-2.0+ WITH Classpath-exception-2.0",
+		},
+		{
+			name:  "Precedence",
+			input: "MIT OR Apache-2.0 AND BSD-3-Clause",
+			expected: chomp.SPDXOr{
+				X: chomp.SPDXLicense{ID: "MIT"},
+				Y: chomp.SPDXAnd{X: chomp.SPDXLicense{ID: "Apache-2.0"}, Y: chomp.SPDXLicense{ID: "BSD-3-Clause"}},
+			},
+			str: "MIT OR Apache-2.0 AND BSD-3-Clause",
+		},
+		{
+			name:  "Parentheses",
+			input: "(MIT OR Apache-2.0) AND DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2",
+			expected: chomp.SPDXAnd{
+				X: chomp.SPDXOr{X: chomp.SPDXLicense{ID: "MIT"}, Y: chomp.SPDXLicense{ID: "Apache-2.0"}},
+				Y: chomp.SPDXLicense{ID: "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2"},
+			},
+			str: "(MIT OR Apache-2.0) AND DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, expr, err := chomp.SPDXExpression()(tt.input)
+
+			require.NoError(t, err)
+			assert.Empty(t, rem)
+			assert.Equal(t, tt.expected, expr)
+			assert.Equal(t, tt.str, expr.String())
+		})
+	}
+}
+
+func TestSPDXExpressionStopsAtUnknownOperator(t *testing.T) {
+	t.Parallel()
+
+	rem, expr, err := chomp.SPDXExpression()("MIT or Apache-2.0")
+
+	require.NoError(t, err)
+	assert.Equal(t, " or Apache-2.0", rem)
+	assert.Equal(t, chomp.SPDXLicense{ID: "MIT"}, expr)
+}
+
+func TestSPDXExpressionInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+		},
+		{
+			name:  "Operator",
+			input: "AND MIT",
+		},
+		{
+			name:  "DanglingOperator",
+			input: "MIT AND ",
+		},
+		{
+			name:  "UnbalancedParentheses",
+			input: "(MIT OR Apache-2.0",
+		},
+		{
+			name:  "MissingException",
+			input: "GPL-2.0 WITH ",
+		},
+		{
+			name:  "MissingDocumentRef",
+			input: "DocumentRef-spdx-tool-1.2",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.SPDXExpression()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package chomp
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// KnownHostsParts contains the individual parts of a parsed known_hosts entry.
+type KnownHostsParts struct {
+	// Marker is an optional marker, either @cert-authority or @revoked.
+	Marker string
+
+	// Hosts contains each host pattern. A hashed host (|1|salt|hash)
+	// is returned as is.
+	Hosts []string
+
+	// KeyType is the type of the public key, such as ssh-ed25519.
+	KeyType string
+
+	// Key contains the decoded public key.
+	Key []byte
+
+	// Comment is an optional trailing comment.
+	Comment string
+}
+
+type isKnownHostsText struct{}
+
+func (isKnownHostsText) Match(r rune) bool {
+	return r != ' ' && r != '\t' && r != '\r' && r != '\n'
+}
+
+func (isKnownHostsText) String() string {
+	return "is_known_hosts_text"
+}
+
+// KnownHostsEntry will parse a single entry from an SSH known_hosts file. An
+// entry contains an optional marker, a comma separated list of host patterns,
+// the key type, a base64 encoded public key and an optional comment. The public
+// key is decoded. Hashed hosts (|1|salt|hash) are supported. The line ending
+// is consumed.
+//
+//	chomp.KnownHostsEntry()("github.com,140.82.121.4 ssh-ed25519 AAAAC3NzaC1lZDI1NTE5... ci\n")
+//	// ("", chomp.KnownHostsParts{Hosts: []string{"github.com", "140.82.121.4"}, KeyType: "ssh-ed25519", Key: []byte{...}, Comment: "ci"}, nil)
+func KnownHostsEntry() Combinator[KnownHostsParts] {
+	field := Terminated(While(isKnownHostsText{}), WhileN(isBlank{}, 0))
+
+	return func(s string) (string, KnownHostsParts, error) {
+		var entry KnownHostsParts
+
+		rem, _, _ := WhileN(isBlank{}, 0)(s)
+		if strings.HasPrefix(rem, "@") {
+			var err error
+			if rem, entry.Marker, err = field(rem); err != nil {
+				return s, entry, ParserError{Err: err, Type: "known_hosts_entry"}
+			}
+
+			if entry.Marker != "@cert-authority" && entry.Marker != "@revoked" {
+				return s, KnownHostsParts{}, CombinatorParseError{Input: entry.Marker, Text: s, Type: "known_hosts_entry"}
+			}
+		}
+
+		rem, ext, err := All(field, field, field)(rem)
+		if err != nil {
+			return s, KnownHostsParts{}, ParserError{Err: err, Type: "known_hosts_entry"}
+		}
+
+		entry.Hosts = strings.Split(ext[0], ",")
+		for _, host := range entry.Hosts {
+			if host == "" {
+				return s, KnownHostsParts{}, CombinatorParseError{Input: ext[0], Text: s, Type: "known_hosts_entry"}
+			}
+		}
+		entry.KeyType = ext[1]
+
+		if entry.Key, err = base64.StdEncoding.DecodeString(ext[2]); err != nil {
+			return s, KnownHostsParts{}, ParserError{Err: err, Type: "known_hosts_entry"}
+		}
+
+		rem, comment, _ := Eol()(rem)
+		entry.Comment = strings.TrimRight(comment, " \t")
+		return rem, entry, nil
+	}
+}
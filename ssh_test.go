@@ -0,0 +1,105 @@
+package chomp_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ed25519Key = "AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+
+func TestKnownHostsEntry(t *testing.T) {
+	t.Parallel()
+
+	key, _ := base64.StdEncoding.DecodeString(ed25519Key)
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		entry chomp.KnownHostsParts
+	}{
+		{
+			name:  "MultipleHosts",
+			input: "github.com,140.82.121.4 ssh-ed25519 " + ed25519Key + "\n[gitlab.com]:2222 ssh-rsa AAAA",
+			rem:   "[gitlab.com]:2222 ssh-rsa AAAA",
+			entry: chomp.KnownHostsParts{
+				Hosts:   []string{"github.com", "140.82.121.4"},
+				KeyType: "ssh-ed25519",
+				Key:     key,
+			},
+		},
+		{
+			name:  "HashedHostWithComment",
+			input: "|1|JfKTdBh7rNbXkVAQCRp4OQoPfmI=|USECr3SWf1JUPsms5AqfD5QfxkM= ssh-ed25519 " + ed25519Key + " added by  ci\r\n",
+			rem:   "",
+			entry: chomp.KnownHostsParts{
+				Hosts:   []string{"|1|JfKTdBh7rNbXkVAQCRp4OQoPfmI=|USECr3SWf1JUPsms5AqfD5QfxkM="},
+				KeyType: "ssh-ed25519",
+				Key:     key,
+				Comment: "added by  ci",
+			},
+		},
+		{
+			name:  "Marker",
+			input: "@cert-authority *.example.com ssh-ed25519 " + ed25519Key,
+			rem:   "",
+			entry: chomp.KnownHostsParts{
+				Marker:  "@cert-authority",
+				Hosts:   []string{"*.example.com"},
+				KeyType: "ssh-ed25519",
+				Key:     key,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, entry, err := chomp.KnownHostsEntry()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.entry, entry)
+		})
+	}
+}
+
+func TestKnownHostsEntryInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "UnknownMarker",
+			input: "@trusted github.com ssh-ed25519 " + ed25519Key,
+		},
+		{
+			name:  "MissingKey",
+			input: "github.com ssh-ed25519\n",
+		},
+		{
+			name:  "MalformedKey",
+			input: "github.com ssh-ed25519 not-base64!",
+		},
+		{
+			name:  "EmptyHostPattern",
+			input: "github.com,,gitlab.com ssh-ed25519 " + ed25519Key,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.KnownHostsEntry()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
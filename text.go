@@ -0,0 +1,161 @@
+package chomp
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+type isShortcode struct{}
+
+func (isShortcode) Match(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '+' || r == '-'
+}
+
+func (isShortcode) String() string {
+	return "is_shortcode"
+}
+
+// EmojiShortcode will match an emoji shortcode delimited (or surrounded) by
+// a pair of :colons:. A shortcode name can only contain lowercase ASCII
+// letters, digits and the characters '_', '+' and '-'. The colons are
+// discarded.
+//
+//	chomp.EmojiShortcode()(":smile: Hello, World!")
+//	// (" Hello, World!", "smile", nil)
+func EmojiShortcode() Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, ext, err := Delimited(Tag(":"), While(isShortcode{}), Tag(":"))(s)
+		if err != nil {
+			return s, "", CombinatorParseError{Text: s, Type: "emoji_shortcode"}
+		}
+
+		return rem, ext, nil
+	}
+}
+
+// EmojiShortcodeMap will match an emoji shortcode, as defined by [EmojiShortcode],
+// and resolve it to an emoji using the provided table. The table maps a
+// shortcode name (without colons) to its emoji. A shortcode that does not
+// exist within the table will not match.
+//
+//	chomp.EmojiShortcodeMap(map[string]string{"wave": "👋"})(":wave: Hello, World!")
+//	// (" Hello, World!", "👋", nil)
+func EmojiShortcodeMap(table map[string]string) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, ext, err := EmojiShortcode()(s)
+		if err != nil {
+			return rem, "", err
+		}
+
+		if emoji, ok := table[ext]; ok {
+			return rem, emoji, nil
+		}
+
+		return s, "", CombinatorParseError{Input: ext, Text: s, Type: "emoji_shortcode_map"}
+	}
+}
+
+type isSocialTag struct{}
+
+func (isSocialTag) Match(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_'
+}
+
+func (isSocialTag) String() string {
+	return "is_social_tag"
+}
+
+// Mention will match an @mention, returning the username without its leading
+// '@'. A username can contain any Unicode letter or digit, along with an
+// underscore. The username must not be immediately followed by another
+// '@' or '#', as in "@user@example.com", ensuring only whole tokens are
+// matched. As a [Combinator] only sees the text from its current position,
+// it cannot check the preceding character, such as within "foo@bar". Use
+// [FindMentions] to extract mentions from an entire message.
+//
+//	chomp.Mention()("@purpleclay thanks!")
+//	// (" thanks!", "purpleclay", nil)
+func Mention() Combinator[string] {
+	return socialTag('@', "mention")
+}
+
+// Hashtag will match a #hashtag, returning the tag without its leading '#'.
+// A tag can contain any Unicode letter or digit, along with an underscore.
+// The tag must not be immediately followed by another '@' or '#', as in
+// "#foo#bar", ensuring only whole tokens are matched. As a [Combinator] only
+// sees the text from its current position, it cannot check the preceding
+// character, such as within "foo#bar". Use [FindHashtags] to extract
+// hashtags from an entire message.
+//
+//	chomp.Hashtag()("#golang is great")
+//	// (" is great", "golang", nil)
+func Hashtag() Combinator[string] {
+	return socialTag('#', "hashtag")
+}
+
+func socialTag(marker rune, typ string) Combinator[string] {
+	return func(s string) (string, string, error) {
+		rem, ext, err := Prefixed(While(isSocialTag{}), Tag(string(marker)))(s)
+		if err != nil {
+			return s, "", CombinatorParseError{Text: s, Type: typ}
+		}
+
+		if _, _, err := OneOf("@#")(rem); err == nil {
+			return s, "", CombinatorParseError{Text: s, Type: typ}
+		}
+
+		return rem, ext, nil
+	}
+}
+
+// FindMentions will scan an entire message for any @mention, returning each
+// username without its leading '@', in the order they appear. A mention must
+// start a new token, and is ignored if immediately preceded by a letter, digit,
+// underscore, '@' or '#', as within "foo@bar". It will never return an error.
+//
+//	chomp.FindMentions()("thanks @purpleclay, not user@example.com")
+//	// ("", []string{"purpleclay"}, nil)
+func FindMentions() Combinator[[]string] {
+	return findSocialTags('@', Mention())
+}
+
+// FindHashtags will scan an entire message for any #hashtag, returning each
+// tag without its leading '#', in the order they appear. A hashtag must start
+// a new token, and is ignored if immediately preceded by a letter, digit,
+// underscore, '@' or '#', as within "foo#bar". It will never return an error.
+//
+//	chomp.FindHashtags()("#golang is great, foo#bar is not")
+//	// ("", []string{"golang"}, nil)
+func FindHashtags() Combinator[[]string] {
+	return findSocialTags('#', Hashtag())
+}
+
+func findSocialTags(marker rune, tag Combinator[string]) Combinator[[]string] {
+	return func(s string) (string, []string, error) {
+		var tags []string
+
+		prev := ' '
+		for rem := s; rem != ""; {
+			r, size := utf8.DecodeRuneInString(rem)
+			if r == marker && !continuesSocialTag(prev) {
+				if next, ext, err := tag(rem); err == nil {
+					tags = append(tags, ext)
+					prev, _ = utf8.DecodeLastRuneInString(ext)
+					rem = next
+					continue
+				}
+			}
+
+			prev = r
+			rem = rem[size:]
+		}
+
+		return "", tags, nil
+	}
+}
+
+// continuesSocialTag reports whether a marker preceded by the rune would
+// continue an existing token, rather than start a new one
+func continuesSocialTag(r rune) bool {
+	return isSocialTag{}.Match(r) || r == '@' || r == '#'
+}
@@ -0,0 +1,265 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmojiShortcode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Simple",
+			input: ":smile: Hello, World!",
+			rem:   " Hello, World!",
+			ext:   "smile",
+		},
+		{
+			name:  "AllowedCharacters",
+			input: ":+1_thumbs-up2:",
+			rem:   "",
+			ext:   "+1_thumbs-up2",
+		},
+		{
+			name:  "Consecutive",
+			input: ":wave::smile:",
+			rem:   ":smile:",
+			ext:   "wave",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.EmojiShortcode()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestEmojiShortcodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Uppercase",
+			input: ":Smile:",
+		},
+		{
+			name:  "Empty",
+			input: "::",
+		},
+		{
+			name:  "Unterminated",
+			input: ":smile",
+		},
+		{
+			name:  "Whitespace",
+			input: ":big smile:",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.EmojiShortcode()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestEmojiShortcodeMap(t *testing.T) {
+	t.Parallel()
+
+	table := map[string]string{"wave": "👋", "smile": "😄"}
+
+	rem, ext, err := chomp.EmojiShortcodeMap(table)(":wave: Hello, World!")
+	require.NoError(t, err)
+	assert.Equal(t, " Hello, World!", rem)
+	assert.Equal(t, "👋", ext)
+
+	rem, _, err = chomp.EmojiShortcodeMap(table)(":frown:")
+	require.Error(t, err)
+	assert.Equal(t, ":frown:", rem)
+}
+
+func TestMention(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Ascii",
+			input: "@purple_clay thanks!",
+			rem:   " thanks!",
+			ext:   "purple_clay",
+		},
+		{
+			name:  "Unicode",
+			input: "@こんにちは、おはよう",
+			rem:   "、おはよう",
+			ext:   "こんにちは",
+		},
+		{
+			name:  "TrailingPunctuation",
+			input: "@batman.",
+			rem:   ".",
+			ext:   "batman",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.Mention()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestHashtag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		ext   string
+	}{
+		{
+			name:  "Ascii",
+			input: "#golang2024 is great",
+			rem:   " is great",
+			ext:   "golang2024",
+		},
+		{
+			name:  "Unicode",
+			input: "#ゴー言語!",
+			rem:   "!",
+			ext:   "ゴー言語",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := chomp.Hashtag()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.ext, ext)
+		})
+	}
+}
+
+func TestSocialTagBoundaries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		c     chomp.Combinator[string]
+		input string
+	}{
+		{
+			name:  "HashtagMidWord",
+			c:     chomp.Hashtag(),
+			input: "foo#bar",
+		},
+		{
+			name:  "HashtagFollowedByHashtag",
+			c:     chomp.Hashtag(),
+			input: "#foo#bar",
+		},
+		{
+			name:  "EmptyHashtag",
+			c:     chomp.Hashtag(),
+			input: "# heading",
+		},
+		{
+			name:  "MentionWithinEmail",
+			c:     chomp.Mention(),
+			input: "@user@example.com",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := tt.c(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestFindHashtags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		tags  []string
+	}{
+		{
+			name:  "MidWord",
+			input: "foo#bar",
+			tags:  nil,
+		},
+		{
+			name:  "Message",
+			input: "#golang is great, foo#bar is not #chomp!",
+			tags:  []string{"golang", "chomp"},
+		},
+		{
+			name:  "Adjacent",
+			input: "#foo#bar (#ゴー言語) ##baz",
+			tags:  []string{"ゴー言語"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, tags, err := chomp.FindHashtags()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, "", rem)
+			assert.Equal(t, tt.tags, tags)
+		})
+	}
+}
+
+func TestFindMentions(t *testing.T) {
+	t.Parallel()
+
+	rem, mentions, err := chomp.FindMentions()("thanks @purpleclay and @gopher_42, not user@example.com or @user@example.com")
+
+	require.NoError(t, err)
+	assert.Equal(t, "", rem)
+	assert.Equal(t, []string{"purpleclay", "gopher_42"}, mentions)
+}
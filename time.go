@@ -0,0 +1,161 @@
+package chomp
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TZOffset will parse a timezone offset into a fixed [time.Location]. An offset
+// can either be written as +HHMM or +HH:MM, where the sign is mandatory, or as
+// a 'Z' to denote UTC. As permitted by RFC 3339, a lowercase 'z' is accepted.
+// Hours must be within the range 00-23 and minutes 00-59.
+//
+//	chomp.TZOffset()("-0530 IST")
+//	// (" IST", time.FixedZone("", -19800), nil)
+func TZOffset() Combinator[*time.Location] {
+	return func(s string) (string, *time.Location, error) {
+		if rem, _, err := OneOf("Zz")(s); err == nil {
+			return rem, time.UTC, nil
+		}
+
+		rem, ext, err := All(
+			OneOf("+-"),
+			TakeWhileMN(2, 2, isASCIIDigit{}.Match),
+			Opt(Tag(":")),
+			TakeWhileMN(2, 2, isASCIIDigit{}.Match))(s)
+		if err != nil {
+			return s, nil, ParserError{Err: err, Type: "tz_offset"}
+		}
+
+		hours, _ := strconv.Atoi(ext[1])
+		mins, _ := strconv.Atoi(ext[len(ext)-1])
+		if hours > 23 || mins > 59 {
+			return s, nil, CombinatorParseError{Text: s, Type: "tz_offset"}
+		}
+
+		offset := hours*60*60 + mins*60
+		if ext[0] == "-" {
+			offset = -offset
+		}
+
+		return rem, time.FixedZone("", offset), nil
+	}
+}
+
+// UnixTime will parse a unix timestamp, the number of seconds elapsed since
+// January 1, 1970 UTC, into a [time.Time]. The returned time is in UTC.
+//
+//	chomp.UnixTime()("1704067200 created")
+//	// (" created", 2024-01-01T00:00:00Z, nil)
+func UnixTime() Combinator[time.Time] {
+	return unixTime("unix_time", func(n int64) time.Time { return time.Unix(n, 0) })
+}
+
+// UnixMillis will parse a unix timestamp, the number of milliseconds elapsed
+// since January 1, 1970 UTC, into a [time.Time]. The returned time is in UTC.
+//
+//	chomp.UnixMillis()("1704067200123 created")
+//	// (" created", 2024-01-01T00:00:00.123Z, nil)
+func UnixMillis() Combinator[time.Time] {
+	return unixTime("unix_millis", time.UnixMilli)
+}
+
+// UnixNanos will parse a unix timestamp, the number of nanoseconds elapsed
+// since January 1, 1970 UTC, into a [time.Time]. The returned time is in UTC.
+//
+//	chomp.UnixNanos()("1704067200123456789 created")
+//	// (" created", 2024-01-01T00:00:00.123456789Z, nil)
+func UnixNanos() Combinator[time.Time] {
+	return unixTime("unix_nanos", func(n int64) time.Time { return time.Unix(0, n) })
+}
+
+func unixTime(typ string, conv func(int64) time.Time) Combinator[time.Time] {
+	return func(s string) (string, time.Time, error) {
+		rem, ext, err := While(isASCIIDigit{})(s)
+		if err != nil {
+			return s, time.Time{}, ParserError{Err: err, Type: typ}
+		}
+
+		n, err := strconv.ParseInt(ext, 10, 64)
+		if err != nil {
+			return s, time.Time{}, ParserError{Err: err, Type: typ}
+		}
+
+		return rem, conv(n).UTC(), nil
+	}
+}
+
+// Timestamp will parse an RFC 3339 date-time, YYYY-MM-DDTHH:MM:SS, into a
+// [time.Time]. The date and time can be separated by either a 'T', 't' or a
+// single space. Seconds can include an optional fraction of up to nine digits,
+// with any longer fraction being rejected. An optional timezone offset can
+// follow, written as either 'Z', 'z' or +HH:MM, where the colon is mandatory.
+// If omitted, the time is assumed to be in UTC. If an offset is started, with
+// a '+', '-', 'Z' or 'z', it must be valid. Each field is validated, rejecting
+// dates such as 2023-02-30.
+//
+//	chomp.Timestamp()("2024-01-01T12:30:45.123+01:00 INFO started")
+//	// (" INFO started", 2024-01-01T12:30:45.123+01:00, nil)
+func Timestamp() Combinator[time.Time] {
+	return func(s string) (string, time.Time, error) {
+		rem, ext, err := All(
+			TakeWhileMN(4, 4, isASCIIDigit{}.Match),
+			Tag("-"),
+			TakeWhileMN(2, 2, isASCIIDigit{}.Match),
+			Tag("-"),
+			TakeWhileMN(2, 2, isASCIIDigit{}.Match),
+			OneOf("Tt "),
+			TakeWhileMN(2, 2, isASCIIDigit{}.Match),
+			Tag(":"),
+			TakeWhileMN(2, 2, isASCIIDigit{}.Match),
+			Tag(":"),
+			TakeWhileMN(2, 2, isASCIIDigit{}.Match))(s)
+		if err != nil {
+			return s, time.Time{}, ParserError{Err: err, Type: "timestamp"}
+		}
+		ext[5] = "T"
+
+		if next, frac, err := Pair(Tag("."), TakeWhileMN(1, 9, isASCIIDigit{}.Match))(rem); err == nil {
+			if next != "" && (isASCIIDigit{}).Match(rune(next[0])) {
+				return s, time.Time{}, ParserError{
+					Err:  CombinatorParseError{Text: rem, Type: "timestamp_fraction"},
+					Type: "timestamp",
+				}
+			}
+
+			rem = next
+			ext = append(ext, frac...)
+		}
+
+		loc := time.UTC
+		if _, _, err := OneOf("+-Zz")(rem); err == nil {
+			if rem, loc, err = rfc3339Offset(rem); err != nil {
+				return s, time.Time{}, ParserError{Err: err, Type: "timestamp"}
+			}
+		}
+
+		t, err := time.ParseInLocation("2006-01-02T15:04:05", strings.Join(ext, ""), loc)
+		if err != nil {
+			return s, time.Time{}, ParserError{Err: err, Type: "timestamp"}
+		}
+
+		return rem, t, nil
+	}
+}
+
+// rfc3339Offset parses a timezone offset using [TZOffset], additionally
+// requiring a numeric offset to be separated by a colon, +HH:MM
+func rfc3339Offset(s string) (string, *time.Location, error) {
+	if _, _, err := OneOf("+-")(s); err == nil {
+		if _, _, err := All(
+			OneOf("+-"),
+			TakeWhileMN(2, 2, isASCIIDigit{}.Match),
+			Tag(":"),
+			TakeWhileMN(2, 2, isASCIIDigit{}.Match))(s); err != nil {
+			return s, nil, ParserError{Err: err, Type: "tz_offset"}
+		}
+	}
+
+	return TZOffset()(s)
+}
@@ -0,0 +1,296 @@
+package chomp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTZOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  string
+		rem    string
+		offset int
+	}{
+		{
+			name:   "Positive",
+			input:  "+0100",
+			offset: 60 * 60,
+		},
+		{
+			name:   "NegativeWithMinutes",
+			input:  "-0530 IST",
+			rem:    " IST",
+			offset: -(5*60*60 + 30*60),
+		},
+		{
+			name:   "Colon",
+			input:  "+09:45",
+			offset: 9*60*60 + 45*60,
+		},
+		{
+			name:   "UTC",
+			input:  "Z",
+			offset: 0,
+		},
+		{
+			name:   "LowercaseUTC",
+			input:  "z INFO",
+			rem:    " INFO",
+			offset: 0,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, loc, err := chomp.TZOffset()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+
+			_, offset := time.Date(2024, 1, 1, 0, 0, 0, 0, loc).Zone()
+			assert.Equal(t, tt.offset, offset)
+		})
+	}
+}
+
+func TestTZOffsetInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "HoursOutOfRange",
+			input: "+25:00",
+		},
+		{
+			name:  "MinutesOutOfRange",
+			input: "+0160",
+		},
+		{
+			name:  "MissingSign",
+			input: "0100",
+		},
+		{
+			name:  "TooShort",
+			input: "+1",
+		},
+		{
+			name:  "UnicodeDigits",
+			input: "+٠١٠٠",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, loc, err := chomp.TZOffset()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.Nil(t, loc)
+		})
+	}
+}
+
+func TestUnixTime(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		unix     chomp.Combinator[time.Time]
+		input    string
+		expected string
+	}{
+		{
+			name:     "Seconds",
+			unix:     chomp.UnixTime(),
+			input:    "1704067200 created",
+			expected: "2024-01-01T00:00:00Z",
+		},
+		{
+			name:     "Millis",
+			unix:     chomp.UnixMillis(),
+			input:    "1704067200123 created",
+			expected: "2024-01-01T00:00:00.123Z",
+		},
+		{
+			name:     "Nanos",
+			unix:     chomp.UnixNanos(),
+			input:    "1704067200123456789 created",
+			expected: "2024-01-01T00:00:00.123456789Z",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, ext, err := tt.unix(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, " created", rem)
+			assert.Equal(t, tt.expected, ext.Format(time.RFC3339Nano))
+		})
+	}
+}
+
+func TestUnixTimeInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "NoDigits",
+			input: "yesterday",
+		},
+		{
+			name:  "Overflow",
+			input: "9999999999999999999",
+		},
+		{
+			name:  "UnicodeDigits",
+			input: "١٧٠٠",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rem, _, err := chomp.UnixTime()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+		})
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+	}{
+		{
+			name:     "UTC",
+			input:    "2024-01-01T12:30:45Z",
+			expected: time.Date(2024, 1, 1, 12, 30, 45, 0, time.UTC),
+		},
+		{
+			name:     "LowercaseUTC",
+			input:    "2024-01-01t12:30:45z",
+			expected: time.Date(2024, 1, 1, 12, 30, 45, 0, time.UTC),
+		},
+		{
+			name:     "Offset",
+			input:    "2024-01-01T12:30:45+01:00",
+			expected: time.Date(2024, 1, 1, 12, 30, 45, 0, time.FixedZone("", 3600)),
+		},
+		{
+			name:     "FractionalSeconds",
+			input:    "2024-02-29t23:59:59.123456789-05:30",
+			expected: time.Date(2024, 2, 29, 23, 59, 59, 123456789, time.FixedZone("", -19800)),
+		},
+		{
+			name:     "SpaceSeparator",
+			input:    "2024-01-01 08:00:00.5",
+			expected: time.Date(2024, 1, 1, 8, 0, 0, 500000000, time.UTC),
+		},
+		{
+			name:     "NoOffset",
+			input:    "1999-12-31T23:59:59",
+			expected: time.Date(1999, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, ts, err := chomp.Timestamp()(tt.input + " INFO started")
+
+			require.NoError(t, err)
+			assert.Equal(t, " INFO started", rem)
+			assert.True(t, tt.expected.Equal(ts), "expected %s, got %s", tt.expected, ts)
+			assert.Equal(t, tt.expected.Format(time.RFC3339Nano), ts.Format(time.RFC3339Nano))
+		})
+	}
+}
+
+func TestTimestampInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "DateOnly",
+			input: "2024-01-01",
+			err:   "(one_of) combinator failed",
+		},
+		{
+			name:  "InvalidDay",
+			input: "2023-02-30T00:00:00Z",
+			err:   "day out of range",
+		},
+		{
+			name:  "InvalidHour",
+			input: "2024-01-01T24:00:00Z",
+			err:   "hour out of range",
+		},
+		{
+			name:  "ShortYear",
+			input: "24-01-01T00:00:00Z",
+			err:   "(take_while_m_n) parser failed",
+		},
+		{
+			name:  "InvalidOffset",
+			input: "2024-01-02T03:04:05+25:99 rest",
+			err:   "(tz_offset) combinator failed to parse text '+25:99 rest'",
+		},
+		{
+			name:  "FractionTooLong",
+			input: "2024-01-01T12:30:45.1234567890Z",
+			err:   "(timestamp_fraction) combinator failed to parse text '.1234567890Z'",
+		},
+		{
+			name:  "OffsetWithoutColon",
+			input: "2024-01-01T12:30:45+0100",
+			err:   "(tag) combinator failed to parse text '00' with input ':'",
+		},
+		{
+			name:  "TruncatedOffset",
+			input: "2024-01-02T03:04:05-1",
+			err:   "(tz_offset) parser failed",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.Timestamp()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(timestamp) parser failed")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package chomp
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+var (
+	// TraceWriter is where all output from [Trace] is written. It defaults
+	// to [os.Stderr] and can be set to [io.Discard] to silence all output.
+	TraceWriter io.Writer = os.Stderr
+
+	// TraceInputLen is the maximum number of bytes of input text written by
+	// [Trace] upon entering a [Combinator].
+	TraceInputLen = 20
+)
+
+// Trace will write diagnostic output to [TraceWriter] each time the [Combinator]
+// is invoked. Upon entering, the name and the start of the input text, limited
+// to [TraceInputLen] bytes, is written. Upon returning, either the length of the
+// remaining text or the error is written. It is designed to be temporarily added
+// when debugging a grammar and does not modify the behavior of the [Combinator].
+//
+//	chomp.Trace("greeting", chomp.Tag("Hello"))("Hello, World!")
+//	// (", World!", "Hello", nil)
+//	//
+//	// stderr:
+//	// [trace] greeting: enter "Hello, World!"
+//	// [trace] greeting: ok rem=8
+func Trace[T any](name string, c Combinator[T]) Combinator[T] {
+	return func(s string) (string, T, error) {
+		input := s
+		if len(input) > TraceInputLen {
+			input = input[:TraceInputLen]
+		}
+		fmt.Fprintf(TraceWriter, "[trace] %s: enter %q\n", name, input)
+
+		rem, ext, err := c(s)
+		if err != nil {
+			fmt.Fprintf(TraceWriter, "[trace] %s: error %v\n", name, err)
+		} else {
+			fmt.Fprintf(TraceWriter, "[trace] %s: ok rem=%d\n", name, len(rem))
+		}
+
+		return rem, ext, err
+	}
+}
@@ -0,0 +1,30 @@
+package chomp_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrace(t *testing.T) {
+	var buf bytes.Buffer
+	orig := chomp.TraceWriter
+	chomp.TraceWriter = &buf
+	t.Cleanup(func() { chomp.TraceWriter = orig })
+
+	rem, ext, err := chomp.Pair(
+		chomp.Trace("greeting", chomp.Tag("Hello")),
+		chomp.Trace("separator", chomp.Tag(";")))("Hello, World! It's a great day")
+
+	require.Error(t, err)
+	assert.Equal(t, ", World! It's a great day", rem)
+	assert.Nil(t, ext)
+	assert.Equal(t, `[trace] greeting: enter "Hello, World! It's a"
+[trace] greeting: ok rem=25
+[trace] separator: enter ", World! It's a grea"
+[trace] separator: error (tag) combinator failed to parse text ', World! It's a great day' with input ';'
+`, buf.String())
+}
@@ -0,0 +1,125 @@
+package chomp
+
+import "fmt"
+
+// Values2 contains the typed results of two combinators, as returned by [Pair2].
+type Values2[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Values3 contains the typed results of three combinators, as returned by [Tuple3].
+type Values3[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Values4 contains the typed results of four combinators, as returned by [Tuple4].
+type Values4[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+func tupleErr(err error, pos int, typ string) error {
+	return ParserError{Err: fmt.Errorf("position %d: %w", pos, err), Type: typ}
+}
+
+// Pair2 will scan the input text and match each [Combinator] in turn. Both
+// combinators must match. Unlike [Pair], the results are not flattened into
+// a string slice, but returned as a typed [Values2], allowing combinators of
+// differing types to be combined. Any error identifies the position of the
+// failing combinator.
+//
+//	chomp.Pair2(
+//		chomp.Tag("Hello"),
+//		chomp.Many(chomp.OneOf(", ")))("Hello, World!")
+//	// ("World!", chomp.Values2[string, []string]{First: "Hello", Second: []string{",", " "}}, nil)
+func Pair2[A, B any](c1 Combinator[A], c2 Combinator[B]) Combinator[Values2[A, B]] {
+	return func(s string) (string, Values2[A, B], error) {
+		var out Values2[A, B]
+		var err error
+
+		rem := s
+		if rem, out.First, err = c1(rem); err != nil {
+			return rem, Values2[A, B]{}, tupleErr(err, 0, "pair2")
+		}
+
+		if rem, out.Second, err = c2(rem); err != nil {
+			return rem, Values2[A, B]{}, tupleErr(err, 1, "pair2")
+		}
+
+		return rem, out, nil
+	}
+}
+
+// Tuple3 will scan the input text and match each [Combinator] in turn. All
+// combinators must match. The results are returned as a typed [Values3],
+// allowing combinators of differing types to be combined. Any error
+// identifies the position of the failing combinator.
+//
+//	chomp.Tuple3(
+//		chomp.Tag("Hello"),
+//		chomp.Many(chomp.OneOf(", ")),
+//		chomp.Tag("World"))("Hello, World!")
+//	// ("!", chomp.Values3[string, []string, string]{First: "Hello", Second: []string{",", " "}, Third: "World"}, nil)
+func Tuple3[A, B, C any](c1 Combinator[A], c2 Combinator[B], c3 Combinator[C]) Combinator[Values3[A, B, C]] {
+	return func(s string) (string, Values3[A, B, C], error) {
+		var out Values3[A, B, C]
+		var err error
+
+		rem := s
+		if rem, out.First, err = c1(rem); err != nil {
+			return rem, Values3[A, B, C]{}, tupleErr(err, 0, "tuple3")
+		}
+
+		if rem, out.Second, err = c2(rem); err != nil {
+			return rem, Values3[A, B, C]{}, tupleErr(err, 1, "tuple3")
+		}
+
+		if rem, out.Third, err = c3(rem); err != nil {
+			return rem, Values3[A, B, C]{}, tupleErr(err, 2, "tuple3")
+		}
+
+		return rem, out, nil
+	}
+}
+
+// Tuple4 will scan the input text and match each [Combinator] in turn. All
+// combinators must match. The results are returned as a typed [Values4],
+// allowing combinators of differing types to be combined. Any error
+// identifies the position of the failing combinator.
+//
+//	chomp.Tuple4(
+//		chomp.Tag("Hello"),
+//		chomp.Tag(", "),
+//		chomp.Tag("World"),
+//		chomp.S(chomp.Tag("!")))("Hello, World!")
+//	// ("", chomp.Values4[string, string, string, []string]{First: "Hello", Second: ", ", Third: "World", Fourth: []string{"!"}}, nil)
+func Tuple4[A, B, C, D any](c1 Combinator[A], c2 Combinator[B], c3 Combinator[C], c4 Combinator[D]) Combinator[Values4[A, B, C, D]] {
+	return func(s string) (string, Values4[A, B, C, D], error) {
+		var out Values4[A, B, C, D]
+		var err error
+
+		rem := s
+		if rem, out.First, err = c1(rem); err != nil {
+			return rem, Values4[A, B, C, D]{}, tupleErr(err, 0, "tuple4")
+		}
+
+		if rem, out.Second, err = c2(rem); err != nil {
+			return rem, Values4[A, B, C, D]{}, tupleErr(err, 1, "tuple4")
+		}
+
+		if rem, out.Third, err = c3(rem); err != nil {
+			return rem, Values4[A, B, C, D]{}, tupleErr(err, 2, "tuple4")
+		}
+
+		if rem, out.Fourth, err = c4(rem); err != nil {
+			return rem, Values4[A, B, C, D]{}, tupleErr(err, 3, "tuple4")
+		}
+
+		return rem, out, nil
+	}
+}
@@ -0,0 +1,68 @@
+package chomp_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func number() chomp.Combinator[int] {
+	return chomp.Combinator[int](chomp.Map(chomp.While(chomp.IsDigit), func(in string) int {
+		n, _ := strconv.Atoi(in)
+		return n
+	}))
+}
+
+func TestPair2(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Pair2(chomp.Tag("@@ -"), number())("@@ -10,7")
+
+	require.NoError(t, err)
+	assert.Equal(t, ",7", rem)
+	assert.Equal(t, "@@ -", ext.First)
+	assert.Equal(t, 10, ext.Second)
+}
+
+func TestTuple3(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Tuple3(
+		number(),
+		chomp.Many(chomp.OneOf(",;")),
+		chomp.Opt(number()))("10,;7 lines")
+
+	require.NoError(t, err)
+	assert.Equal(t, " lines", rem)
+	assert.Equal(t, chomp.Values3[int, []string, int]{First: 10, Second: []string{",", ";"}, Third: 7}, ext)
+}
+
+func TestTuple4(t *testing.T) {
+	t.Parallel()
+
+	rem, ext, err := chomp.Tuple4(
+		chomp.Tag("v"),
+		number(),
+		chomp.Tag("."),
+		number())("v1.22")
+
+	require.NoError(t, err)
+	assert.Empty(t, rem)
+	assert.Equal(t, chomp.Values4[string, int, string, int]{First: "v", Second: 1, Third: ".", Fourth: 22}, ext)
+}
+
+func TestTupleErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	_, ext, err := chomp.Tuple3(chomp.Tag("v"), number(), chomp.Tag("."))("v1-22")
+
+	require.Error(t, err)
+	assert.Equal(t, chomp.Values3[string, int, string]{}, ext)
+	assert.EqualError(t, err, "(tuple3) parser failed. position 2: (tag) combinator failed to parse text '-22' with input '.'")
+
+	var combErr chomp.CombinatorParseError
+	require.ErrorAs(t, err, &combErr)
+}
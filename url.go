@@ -0,0 +1,109 @@
+package chomp
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// URLParts contains the individual parts of a parsed URL.
+type URLParts struct {
+	// Scheme of the URL, such as https. It is normalized to lowercase.
+	Scheme string
+
+	// Host is either a hostname or an IP address. An IPv6 address is returned
+	// without its enclosing brackets.
+	Host string
+
+	// Port number. It will be zero if not provided.
+	Port int
+
+	// Path of the URL, including its leading '/'. Any percent-encoded
+	// characters are decoded.
+	Path string
+
+	// Query string, without its leading '?'. It is returned as is, allowing
+	// it to be parsed using [url.ParseQuery].
+	Query string
+
+	// Fragment of the URL, without its leading '#'. Any percent-encoded
+	// characters are decoded.
+	Fragment string
+}
+
+type isURLEnd struct{}
+
+func (isURLEnd) Match(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune("<>\"`{}|\\^", r)
+}
+
+func (isURLEnd) String() string {
+	return "is_url_end"
+}
+
+// URL will parse a URL with an authority, in the format
+// scheme://host:port/path?query#fragment, typically when embedded within
+// some text. Only the scheme and host are required. Parsing stops at the first
+// whitespace character, or any character that cannot appear within a URL,
+// such as '<' or '"'. Any trailing punctuation (.,;:!?') and unbalanced
+// closing brackets are treated as part of the surrounding text. URLs without
+// an authority, such as mailto:, are not supported.
+//
+//	chomp.URL()("see https://example.com:8443/docs?page=2#intro.")
+//	// (".", chomp.URLParts{Scheme: "https", Host: "example.com", Port: 8443, Path: "/docs", Query: "page=2", Fragment: "intro"}, nil)
+func URL() Combinator[URLParts] {
+	return func(s string) (string, URLParts, error) {
+		rem, _, err := Terminated(dsnScheme(), Tag("://"))(s)
+		if err != nil {
+			return s, URLParts{}, ParserError{Err: err, Type: "url"}
+		}
+
+		_, text, _ := WhileNot(isURLEnd{})(rem)
+		text = s[:len(s)-len(rem)] + urlTrimTrailing(text)
+
+		u, err := url.Parse(text)
+		if err != nil {
+			return s, URLParts{}, ParserError{Err: err, Type: "url"}
+		}
+
+		if u.Host == "" {
+			return s, URLParts{}, ParserError{
+				Err:  CombinatorParseError{Text: text, Type: "url_host"},
+				Type: "url",
+			}
+		}
+
+		_, hp, err := AllConsuming(HostPort())(u.Host)
+		if err != nil {
+			return s, URLParts{}, ParserError{Err: err, Type: "url"}
+		}
+
+		return s[len(text):], URLParts{
+			Scheme:   strings.ToLower(u.Scheme),
+			Host:     hp.Host,
+			Port:     hp.Port,
+			Path:     u.Path,
+			Query:    u.RawQuery,
+			Fragment: u.Fragment,
+		}, nil
+	}
+}
+
+// urlTrimTrailing removes any trailing punctuation that is more likely to
+// belong to the surrounding text, such as a full stop ending a sentence, or
+// a closing bracket without a matching opening bracket
+func urlTrimTrailing(text string) string {
+	for text != "" {
+		switch last := text[len(text)-1]; {
+		case strings.IndexByte(".,;:!?'", last) != -1:
+			text = text[:len(text)-1]
+		case last == ')' && strings.Count(text, "(") < strings.Count(text, ")"):
+			text = text[:len(text)-1]
+		case last == ']' && strings.Count(text, "[") < strings.Count(text, "]"):
+			text = text[:len(text)-1]
+		default:
+			return text
+		}
+	}
+	return text
+}
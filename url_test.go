@@ -0,0 +1,128 @@
+package chomp_test
+
+import (
+	"testing"
+
+	"github.com/purpleclay/chomp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		rem   string
+		url   chomp.URLParts
+	}{
+		{
+			name:  "HostOnly",
+			input: "http://example.com",
+			rem:   "",
+			url:   chomp.URLParts{Scheme: "http", Host: "example.com"},
+		},
+		{
+			name:  "AllParts",
+			input: "HTTPS://example.com:8443/docs/getting%20started?page=2&lang=en#intro and more",
+			rem:   " and more",
+			url: chomp.URLParts{
+				Scheme:   "https",
+				Host:     "example.com",
+				Port:     8443,
+				Path:     "/docs/getting started",
+				Query:    "page=2&lang=en",
+				Fragment: "intro",
+			},
+		},
+		{
+			name:  "EndOfSentence",
+			input: "https://github.com/purpleclay/chomp.",
+			rem:   ".",
+			url:   chomp.URLParts{Scheme: "https", Host: "github.com", Path: "/purpleclay/chomp"},
+		},
+		{
+			name:  "TrailingPunctuation",
+			input: "https://example.com/?q=chomp!), right?",
+			rem:   "!), right?",
+			url:   chomp.URLParts{Scheme: "https", Host: "example.com", Path: "/", Query: "q=chomp"},
+		},
+		{
+			name:  "BalancedBrackets",
+			input: "https://en.wikipedia.org/wiki/Parsing_(computing))",
+			rem:   ")",
+			url:   chomp.URLParts{Scheme: "https", Host: "en.wikipedia.org", Path: "/wiki/Parsing_(computing)"},
+		},
+		{
+			name:  "AngleBrackets",
+			input: "http://[::1]:8080/health>",
+			rem:   ">",
+			url:   chomp.URLParts{Scheme: "http", Host: "::1", Port: 8080, Path: "/health"},
+		},
+		{
+			name:  "UserInfo",
+			input: "ftp://anonymous@ftp.example.com/pub",
+			rem:   "",
+			url:   chomp.URLParts{Scheme: "ftp", Host: "ftp.example.com", Path: "/pub"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, url, err := chomp.URL()(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.rem, rem)
+			assert.Equal(t, tt.url, url)
+		})
+	}
+}
+
+func TestURLInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "MissingScheme",
+			input: "example.com/docs",
+			err:   "(tag) combinator failed to parse text '/docs' with input '://'",
+		},
+		{
+			name:  "NoAuthority",
+			input: "mailto:john@example.com",
+			err:   "with input '://'",
+		},
+		{
+			name:  "MissingHost",
+			input: "file:///etc/hosts",
+			err:   "(url_host) combinator failed to parse text 'file:///etc/hosts'",
+		},
+		{
+			name:  "InvalidPort",
+			input: "http://example.com:99999/",
+			err:   "(port) combinator failed",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem, _, err := chomp.URL()(tt.input)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.input, rem)
+			assert.ErrorContains(t, err, "(url) parser failed")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}